@@ -31,6 +31,7 @@ func main() {
 	callListNewLikedYou(ctx, c)
 	callCountLikedYou(ctx, c)
 	callPutDecision(ctx, c)
+	callListMatches(ctx, c)
 }
 
 func callListLikedYou(ctx context.Context, c pb.ExploreServiceClient) {
@@ -78,3 +79,14 @@ func callPutDecision(ctx context.Context, c pb.ExploreServiceClient) {
 	}
 	log.Printf("PutDecision response: %s\n\n", resp)
 }
+
+func callListMatches(ctx context.Context, c pb.ExploreServiceClient) {
+	req := pb.ListMatchesRequest{
+		UserId: "2b13bf3c-b7e3-11f0-add8-627f4e32ceb4",
+	}
+	resp, err := c.ListMatches(ctx, &req)
+	if err != nil {
+		log.Fatalf("could not greet: %v", err)
+	}
+	log.Printf("ListMatches response: %s\n\n", resp)
+}
@@ -0,0 +1,29 @@
+// Reconcile recomputes the liked_you_counts counters that drifted from the
+// decisions table, it is safe to run against a live database.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+)
+
+var batchSize = flag.Int("batch-size", 1000, "number of users checked per query")
+
+func main() {
+	flag.Parse()
+
+	db, err := dataaccess.OpenDB(dataaccess.ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	fixed, err := dataaccess.NewRepository(db).ReconcileLikedYouCounts(context.Background(), *batchSize)
+	if err != nil {
+		log.Fatalf("reconcile failed after fixing %d counters: %v", fixed, err)
+	}
+	log.Printf("reconciled liked_you_counts, fixed %d counters", fixed)
+}
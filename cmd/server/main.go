@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/jacob-alt-del/explore-service/internal/cache"
 	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/outbox"
 	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/quota"
+	"github.com/jacob-alt-del/explore-service/internal/ratelimit"
 	"github.com/jacob-alt-del/explore-service/internal/service"
+	"github.com/jacob-alt-del/explore-service/internal/webhook"
 	"google.golang.org/grpc"
 )
 
@@ -19,31 +28,42 @@ func main() {
 		port = "50051"
 	}
 
-	dbUser := os.Getenv("DB_USER")
-	if dbUser == "" {
-		dbUser = "root"
-	}
-
-	dbPassword := os.Getenv("DB_PASSWORD")
-	if dbPassword == "" {
-		dbPassword = "secret"
-	}
-
-	dbHost := os.Getenv("DB_HOST")
-	if dbHost == "" {
-		dbHost = "localhost:3306"
+	backend := os.Getenv("DB_BACKEND")
+	publisher, err := outboxPublisher(backend)
+	if err != nil {
+		log.Fatalf("failed to setup outbox publisher: %v", err)
 	}
+	endpoints := splitList(os.Getenv("WEBHOOK_URLS"))
+	// without a relay nothing would ever delete the outbox rows
+	relay := publisher != nil || len(endpoints) > 0
 
-	dbName := os.Getenv("DB_NAME")
-	if dbName == "" {
-		dbName = "explore"
+	var repo dataaccess.Store
+	switch backend {
+	case "memory":
+		log.Printf("using in-memory backend, data will not be persisted")
+		memoryRepo := dataaccess.NewMemoryRepository()
+		if !relay {
+			memoryRepo.DisableOutbox()
+		}
+		repo = memoryRepo
+	case "", "mysql":
+		mysqlRepo, err := dataaccess.SetupRepository(dataaccess.ConfigFromEnv())
+		if err != nil {
+			log.Fatalf("failed to setup database: %v", err)
+		}
+		defer mysqlRepo.Close()
+		if !relay {
+			mysqlRepo.DisableOutbox()
+		}
+		repo = mysqlRepo
+	default:
+		log.Fatalf("unknown DB_BACKEND %q", backend)
 	}
 
-	repo, err := dataaccess.SetupRepository(dbUser, dbPassword, dbHost, dbName)
+	repo, err = withCache(repo)
 	if err != nil {
-		log.Fatalf("failed to setup database: %v", err)
+		log.Fatalf("failed to setup cache: %v", err)
 	}
-	defer repo.Close()
 
 	flag.Parse()
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
@@ -51,8 +71,41 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	var publishers outbox.MultiPublisher
+	if publisher != nil {
+		publishers = append(publishers, publisher)
+	}
+	if len(endpoints) > 0 {
+		secret := os.Getenv("WEBHOOK_SECRET")
+		if secret == "" {
+			log.Fatalf("WEBHOOK_SECRET is required with WEBHOOK_URLS")
+		}
+		cfg := webhook.DefaultConfig([]byte(secret))
+		if v := os.Getenv("WEBHOOK_MAX_ATTEMPTS"); v != "" {
+			if cfg.MaxAttempts, err = strconv.Atoi(v); err != nil || cfg.MaxAttempts < 1 {
+				log.Fatalf("invalid WEBHOOK_MAX_ATTEMPTS %q", v)
+			}
+		}
+		publishers = append(publishers, webhook.NewPublisher(repo, endpoints))
+		go webhook.NewDispatcher(repo, cfg).Run(context.Background())
+	}
+	if relay {
+		go outbox.NewRelay(repo, publishers, outbox.DefaultConfig()).Run(context.Background())
+	} else {
+		log.Printf("outbox relay disabled, no events are written until OUTBOX_PUBLISHER or WEBHOOK_URLS is set")
+	}
+
+	limits, err := rateLimits()
+	if err != nil {
+		log.Fatalf("failed to setup rate limits: %v", err)
+	}
+	limiter := service.NewRateLimiter(ratelimit.NewMemoryStore(), limits)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(limiter.Unary))
 	exploreService := service.NewExploreServiceServer(repo)
+	if exploreService.Quota, err = likeQuota(repo); err != nil {
+		log.Fatalf("failed to setup like quota: %v", err)
+	}
 	pb.RegisterExploreServiceServer(grpcServer, exploreService)
 
 	log.Printf("server listening at %v", lis.Addr())
@@ -60,3 +113,119 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// outboxPublisher returns the publisher selected by OUTBOX_PUBLISHER, nil
+// when it is disabled, which is the default so user activity is never written
+// to the logs unless asked for. The ndjson publisher appends to
+// OUTBOX_FILE, or writes to stdout without one, and is meant for local use.
+// The memory publisher never lets go of a message, so it is only allowed with
+// the memory backend, whose data is just as short-lived.
+func outboxPublisher(backend string) (outbox.Publisher, error) {
+	switch publisher := os.Getenv("OUTBOX_PUBLISHER"); publisher {
+	case "", "none":
+		return nil, nil
+	case "ndjson":
+		path := os.Getenv("OUTBOX_FILE")
+		if path == "" {
+			return outbox.NewNDJSONPublisher(os.Stdout), nil
+		}
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return outbox.NewNDJSONPublisher(f), nil
+	case "memory":
+		if backend != "memory" {
+			return nil, fmt.Errorf("OUTBOX_PUBLISHER %q requires DB_BACKEND=memory", publisher)
+		}
+		return outbox.NewMemoryPublisher(), nil
+	default:
+		return nil, fmt.Errorf("unknown OUTBOX_PUBLISHER %q", publisher)
+	}
+}
+
+// splitList splits a comma separated list, dropping empty entries.
+func splitList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// withCache puts the read-through cache in front of repo, holding up to
+// CACHE_SIZE entries (default 10000, 0 disables it) for CACHE_TTL.
+func withCache(repo dataaccess.Store) (dataaccess.Store, error) {
+	entries := 10000
+	if v := os.Getenv("CACHE_SIZE"); v != "" {
+		var err error
+		if entries, err = strconv.Atoi(v); err != nil || entries < 0 {
+			return nil, fmt.Errorf("invalid CACHE_SIZE %q", v)
+		}
+	}
+	if entries == 0 {
+		return repo, nil
+	}
+
+	cfg := cache.DefaultConfig()
+	if v := os.Getenv("CACHE_TTL"); v != "" {
+		var err error
+		if cfg.TTL, err = time.ParseDuration(v); err != nil || cfg.TTL <= 0 {
+			return nil, fmt.Errorf("invalid CACHE_TTL %q", v)
+		}
+	}
+	return cache.NewStore(repo, cache.NewLRU(entries), cfg), nil
+}
+
+// rateLimits returns the per actor rate limits, the defaults overridden by
+// RATE_LIMITS, a comma separated list of <rpc>=<requests>/<interval>[:<burst>],
+// e.g. PutDecision=60/1m:20, or <rpc>=off to lift a limit.
+func rateLimits() (map[string]ratelimit.Limit, error) {
+	rpcs := map[string]bool{}
+	for _, m := range pb.ExploreService_ServiceDesc.Methods {
+		rpcs[m.MethodName] = true
+	}
+
+	limits := service.DefaultRateLimits()
+	for _, item := range splitList(os.Getenv("RATE_LIMITS")) {
+		rpc, spec, ok := strings.Cut(item, "=")
+		if !ok || !rpcs[rpc] {
+			return nil, fmt.Errorf("invalid RATE_LIMITS entry %q", item)
+		}
+		if spec == "off" {
+			delete(limits, rpc)
+			continue
+		}
+		limit, err := ratelimit.ParseLimit(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RATE_LIMITS entry %q: %v", item, err)
+		}
+		limits[rpc] = limit
+	}
+	return limits, nil
+}
+
+// likeQuota returns the daily like quota, LIKE_QUOTA likes a day for free
+// users (default 50, 0 disables the quota). Without a subscription system
+// the users listed in PREMIUM_USER_IDS are premium, and so unlimited.
+func likeQuota(repo dataaccess.Store) (*quota.Checker, error) {
+	cfg := quota.DefaultConfig()
+	if v := os.Getenv("LIKE_QUOTA"); v != "" {
+		likes, err := strconv.Atoi(v)
+		if err != nil || likes < 0 {
+			return nil, fmt.Errorf("invalid LIKE_QUOTA %q", v)
+		}
+		if likes == 0 {
+			return nil, nil
+		}
+		cfg.DailyLikes[quota.Free] = likes
+	}
+
+	entitlements := quota.StaticProvider{Default: quota.Free, Users: map[string]quota.Tier{}}
+	for _, id := range splitList(os.Getenv("PREMIUM_USER_IDS")) {
+		entitlements.Users[id] = quota.Premium
+	}
+	return quota.NewChecker(repo, entitlements, cfg), nil
+}
@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache holds opaque values under string keys. Values are bytes so a shared
+// cache can sit behind the interface. Implementations treat errors as misses.
+// A shared implementation should log them, since a failed Delete leaves the
+// entry stale until its TTL runs out.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+	Delete(ctx context.Context, keys ...string)
+}
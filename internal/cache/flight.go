@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// group coalesces concurrent loads of the same key into a single call.
+type group struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done  chan struct{}
+	value []byte
+	err   error
+}
+
+// do calls load once for every set of concurrent callers of the key, the
+// callers that joined an earlier call wait for its result. load runs without
+// the caller's cancellation so one caller giving up doesn't fail the others.
+func (g *group) do(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = map[string]*flight{}
+	}
+	if f, ok := g.flights[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-f.done:
+			return f.value, f.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	f := &flight{done: make(chan struct{})}
+	g.flights[key] = f
+	g.mu.Unlock()
+
+	f.value, f.err = load(context.WithoutCancel(ctx))
+
+	g.mu.Lock()
+	delete(g.flights, key)
+	g.mu.Unlock()
+	close(f.done)
+
+	return f.value, f.err
+}
@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+	clock := time.Unix(1730000000, 0)
+	c.now = func() time.Time { return clock }
+
+	c.Set(ctx, "a", []byte("1"), time.Minute)
+	c.Set(ctx, "b", []byte("2"), time.Minute)
+	c.Get(ctx, "a") // b is now the least recently used
+	c.Set(ctx, "c", []byte("3"), time.Minute)
+
+	if _, ok := c.Get(ctx, "b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	if v, ok := c.Get(ctx, "a"); !ok || string(v) != "1" {
+		t.Errorf("expected a to be kept, got %q", v)
+	}
+
+	c.Delete(ctx, "a", "missing")
+	if _, ok := c.Get(ctx, "a"); ok {
+		t.Errorf("expected a to be deleted")
+	}
+
+	// entries expire after their ttl
+	c.Set(ctx, "c", []byte("4"), 10*time.Second)
+	clock = clock.Add(9 * time.Second)
+	if v, ok := c.Get(ctx, "c"); !ok || string(v) != "4" {
+		t.Errorf("expected the updated c before its ttl, got %q", v)
+	}
+	clock = clock.Add(time.Second)
+	if _, ok := c.Get(ctx, "c"); ok {
+		t.Errorf("expected c to expire")
+	}
+	if c.Len() != 0 {
+		t.Errorf("expected no entries left, got %d", c.Len())
+	}
+}
@@ -0,0 +1,273 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+// generationShards is how many invalidation counters recipients are spread over.
+const generationShards = 1024
+
+var likedYouOrders = []dataaccess.LikedYouOrder{
+	dataaccess.LikedYouFirstLiked,
+	dataaccess.LikedYouRecentActivity,
+	dataaccess.LikedYouOldestFirst,
+}
+
+type Config struct {
+	// TTL bounds how long an entry is served, and so how stale an entry that
+	// missed an invalidation can get.
+	TTL time.Duration
+	// FirstPageSize is how many likers a cached first page holds, requests
+	// for larger pages skip the cache.
+	FirstPageSize int
+}
+
+// DefaultConfig returns the cache settings used by the server.
+func DefaultConfig() Config {
+	return Config{
+		TTL:           30 * time.Second,
+		FirstPageSize: 100,
+	}
+}
+
+// Store is a read-through cache in front of a dataaccess.Store for the
+// requests that are read far more often than they change: CountLikedYou,
+// CountUnseenLikedYou and the first page of ListLikedYou and ListNewLikedYou
+// without a time window. Concurrent misses of the same entry share a single
+// load, which reads from the primary.
+//
+// Writes drop the entries of the recipients they change: decisions those of
+// both users, since liking back changes the actor's ListNewLikedYou, and
+// unmatching or (un)blocking those of the pair. Deactivating, deleting or
+// erasing a user changes the lists of everyone they liked, so those bump an
+// epoch that is part of every key, dropping every entry of this Store. Other
+// servers sharing the Cache only notice the epoch change once their entries
+// expire.
+type Store struct {
+	dataaccess.Store
+	cache   Cache
+	cfg     Config
+	flights group
+	epoch   atomic.Uint64
+	// generations counts the invalidations of each shard of recipients, a
+	// load that raced with one doesn't leave its result behind.
+	generations [generationShards]atomic.Uint64
+}
+
+func NewStore(store dataaccess.Store, cache Cache, cfg Config) *Store {
+	return &Store{Store: store, cache: cache, cfg: cfg}
+}
+
+func (s *Store) CountLikedYou(ctx context.Context, recipientID string, window dataaccess.TimeWindow) (uint64, error) {
+	if !window.IsZero() {
+		return s.Store.CountLikedYou(ctx, recipientID, window)
+	}
+
+	var count uint64
+	err := s.readThrough(ctx, recipientID, "count", &count, func(ctx context.Context) (any, error) {
+		return s.Store.CountLikedYou(ctx, recipientID, window)
+	})
+	return count, err
+}
+
+func (s *Store) CountUnseenLikedYou(ctx context.Context, recipientID string) (uint64, error) {
+	var count uint64
+	err := s.readThrough(ctx, recipientID, "unseen", &count, func(ctx context.Context) (any, error) {
+		return s.Store.CountUnseenLikedYou(ctx, recipientID)
+	})
+	return count, err
+}
+
+func (s *Store) ListLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error) {
+	return s.firstPage(ctx, "all", s.Store.ListLikedYou, recipientID, order, window, cursor, pageSize)
+}
+
+func (s *Store) ListNewLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error) {
+	return s.firstPage(ctx, "new", s.Store.ListNewLikedYou, recipientID, order, window, cursor, pageSize)
+}
+
+type listFunc func(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error)
+
+// firstPage caches the first FirstPageSize likers (plus one to detect a next
+// page) and serves smaller first pages from them.
+func (s *Store) firstPage(
+	ctx context.Context,
+	list string,
+	load listFunc,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error) {
+	if !window.IsZero() || !cursor.IsZero() || pageSize > s.cfg.FirstPageSize {
+		return load(ctx, recipientID, order, window, cursor, pageSize)
+	}
+
+	var decisions []dataaccess.Decision
+	err := s.readThrough(ctx, recipientID, listEntry(list, order), &decisions, func(ctx context.Context) (any, error) {
+		return load(ctx, recipientID, order, window, cursor, s.cfg.FirstPageSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(decisions) > pageSize+1 {
+		decisions = decisions[:pageSize+1]
+	}
+	return decisions, nil
+}
+
+func (s *Store) UpsertDecision(ctx context.Context, actorID, recipientID string, liked bool) error {
+	defer s.invalidate(ctx, actorID, recipientID)
+	return s.Store.UpsertDecision(ctx, actorID, recipientID, liked)
+}
+
+func (s *Store) RecordDecision(ctx context.Context, actorID, recipientID string, liked bool) (dataaccess.DecisionResult, error) {
+	defer s.invalidate(ctx, actorID, recipientID)
+	return s.Store.RecordDecision(ctx, actorID, recipientID, liked)
+}
+
+func (s *Store) RecordDecisions(ctx context.Context, actorID string, decisions []dataaccess.DecisionInput) ([]dataaccess.DecisionResult, error) {
+	ids := []string{actorID}
+	for _, d := range decisions {
+		ids = append(ids, d.RecipientID)
+	}
+	defer s.invalidate(ctx, ids...)
+	return s.Store.RecordDecisions(ctx, actorID, decisions)
+}
+
+func (s *Store) MarkLikesSeen(ctx context.Context, recipientID string, upTo pagination.Cursor) error {
+	defer s.invalidate(ctx, recipientID)
+	return s.Store.MarkLikesSeen(ctx, recipientID, upTo)
+}
+
+func (s *Store) Unmatch(ctx context.Context, actorID, otherID string) error {
+	defer s.invalidate(ctx, actorID, otherID)
+	return s.Store.Unmatch(ctx, actorID, otherID)
+}
+
+func (s *Store) BlockUser(ctx context.Context, blockerID, blockedID string) error {
+	defer s.invalidate(ctx, blockerID, blockedID)
+	return s.Store.BlockUser(ctx, blockerID, blockedID)
+}
+
+func (s *Store) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	defer s.invalidate(ctx, blockerID, blockedID)
+	return s.Store.UnblockUser(ctx, blockerID, blockedID)
+}
+
+func (s *Store) SetUserActive(ctx context.Context, userID string, active bool) (dataaccess.User, error) {
+	defer s.invalidateAll()
+	return s.Store.SetUserActive(ctx, userID, active)
+}
+
+func (s *Store) DeleteUser(ctx context.Context, userID string) error {
+	defer s.invalidateAll()
+	return s.Store.DeleteUser(ctx, userID)
+}
+
+func (s *Store) EraseUserData(ctx context.Context, userID string) (dataaccess.Erasure, error) {
+	defer s.invalidateAll()
+	return s.Store.EraseUserData(ctx, userID)
+}
+
+// readThrough decodes the recipient's entry into dst, loading and caching it
+// on a miss.
+func (s *Store) readThrough(ctx context.Context, recipientID, entry string, dst any, load func(ctx context.Context) (any, error)) error {
+	key := s.key(recipientID, entry)
+	if value, ok := s.cache.Get(ctx, key); ok && json.Unmarshal(value, dst) == nil {
+		return nil
+	}
+
+	generation := s.generation(recipientID).Load()
+	// callers arriving after an invalidation don't join a load that started before it
+	flightKey := fmt.Sprintf("%s@%d", key, generation)
+	value, err := s.flights.do(ctx, flightKey, func(ctx context.Context) ([]byte, error) {
+		// a replica could still be behind the write that invalidated the
+		// entry, and its answer would be cached for the whole TTL
+		v, err := load(dataaccess.ReadPrimary(ctx))
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		// an invalidation either bumps the generation before this check or
+		// deletes the entry after it is set
+		if s.generation(recipientID).Load() == generation {
+			s.cache.Set(ctx, key, value, s.cfg.TTL)
+			if s.generation(recipientID).Load() != generation {
+				s.cache.Delete(ctx, key)
+			}
+		}
+		return value, nil
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(value, dst)
+}
+
+// invalidate drops every entry of the recipients.
+func (s *Store) invalidate(ctx context.Context, recipientIDs ...string) {
+	var keys []string
+	for _, id := range recipientIDs {
+		s.generation(id).Add(1)
+		keys = append(keys, s.key(id, "count"), s.key(id, "unseen"))
+		for _, order := range likedYouOrders {
+			keys = append(keys, s.key(id, listEntry("all", order)), s.key(id, listEntry("new", order)))
+		}
+	}
+	s.cache.Delete(context.WithoutCancel(ctx), keys...)
+}
+
+// invalidateAll drops every entry by moving to a new epoch, the entries of
+// the old one are never read again and age out of the cache.
+func (s *Store) invalidateAll() {
+	s.epoch.Add(1)
+}
+
+func (s *Store) key(recipientID, entry string) string {
+	return fmt.Sprintf("liked_you:%d:%s:%s", s.epoch.Load(), recipientID, entry)
+}
+
+func (s *Store) generation(recipientID string) *atomic.Uint64 {
+	h := fnv.New32a()
+	h.Write([]byte(recipientID))
+	return &s.generations[h.Sum32()%generationShards]
+}
+
+func listEntry(list string, order dataaccess.LikedYouOrder) string {
+	return fmt.Sprintf("%s:%d", list, order)
+}
@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+// countingStore counts the reads reaching the store and can hold their
+// results until released.
+type countingStore struct {
+	dataaccess.Store
+	counts  atomic.Int64
+	lists   atomic.Int64
+	release chan struct{}
+}
+
+func (s *countingStore) CountLikedYou(ctx context.Context, recipientID string, window dataaccess.TimeWindow) (uint64, error) {
+	count, err := s.Store.CountLikedYou(ctx, recipientID, window)
+	s.counts.Add(1)
+	if s.release != nil {
+		<-s.release
+	}
+	return count, err
+}
+
+func (s *countingStore) ListLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error) {
+	s.lists.Add(1)
+	return s.Store.ListLikedYou(ctx, recipientID, order, window, cursor, pageSize)
+}
+
+func newTestStore(t *testing.T, users int) (*Store, *countingStore) {
+	t.Helper()
+	repo := dataaccess.NewMemoryRepository()
+	ids := []string{"recipient"}
+	for i := 0; i < users; i++ {
+		ids = append(ids, fmt.Sprintf("actor-%02d", i))
+	}
+	for _, id := range ids {
+		if _, err := repo.CreateUser(context.Background(), id, "username-"+id); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	counting := &countingStore{Store: repo}
+	return NewStore(counting, NewLRU(100), Config{TTL: time.Minute, FirstPageSize: 10}), counting
+}
+
+func like(t *testing.T, s *Store, actorID, recipientID string) {
+	t.Helper()
+	if _, err := s.RecordDecision(context.Background(), actorID, recipientID, true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestStore_CountLikedYou(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newTestStore(t, 3)
+	like(t, s, "actor-00", "recipient")
+
+	for i := 0; i < 3; i++ {
+		if count, _ := s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{}); count != 1 {
+			t.Fatalf("expected 1, got %d", count)
+		}
+	}
+	if n := counting.counts.Load(); n != 1 {
+		t.Errorf("expected a single read, got %d", n)
+	}
+
+	// a decision aimed at the recipient drops their entry
+	like(t, s, "actor-01", "recipient")
+	if count, _ := s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{}); count != 2 {
+		t.Errorf("expected 2 after the like, got %d", count)
+	}
+
+	// a decision aimed at someone else doesn't
+	like(t, s, "actor-01", "actor-02")
+	_, _ = s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{})
+	if n := counting.counts.Load(); n != 2 {
+		t.Errorf("expected 2 reads, got %d", n)
+	}
+
+	// windows skip the cache
+	_, _ = s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{SinceUnix: 1})
+	_, _ = s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{SinceUnix: 1})
+	if n := counting.counts.Load(); n != 4 {
+		t.Errorf("expected 4 reads, got %d", n)
+	}
+}
+
+func TestStore_ListLikedYou_FirstPage(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newTestStore(t, 12)
+	for i := 0; i < 12; i++ {
+		like(t, s, fmt.Sprintf("actor-%02d", i), "recipient")
+	}
+	list := func(cursor pagination.Cursor, pageSize int) []dataaccess.Decision {
+		decisions, err := s.ListLikedYou(ctx, "recipient", dataaccess.LikedYouFirstLiked, dataaccess.TimeWindow{}, cursor, pageSize)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return decisions
+	}
+
+	// smaller first pages are served from the cached one, with the extra row
+	direct, _ := counting.Store.ListLikedYou(ctx, "recipient", dataaccess.LikedYouFirstLiked, dataaccess.TimeWindow{}, pagination.Cursor{}, 5)
+	if got := list(pagination.Cursor{}, 5); fmt.Sprint(got) != fmt.Sprint(direct) {
+		t.Errorf("expected %v, got %v", direct, got)
+	}
+	if got := list(pagination.Cursor{}, 10); len(got) != 11 {
+		t.Errorf("expected 11 rows, got %d", len(got))
+	}
+	if n := counting.lists.Load(); n != 1 {
+		t.Errorf("expected a single read, got %d", n)
+	}
+
+	// later and larger pages skip the cache
+	list(pagination.Cursor{UnixTs: 1, ID: "actor-05"}, 5)
+	list(pagination.Cursor{}, 11)
+	if n := counting.lists.Load(); n != 3 {
+		t.Errorf("expected 3 reads, got %d", n)
+	}
+
+	// liking back drops the recipient's entries too, it changes their ListNewLikedYou
+	like(t, s, "recipient", "actor-00")
+	list(pagination.Cursor{}, 5)
+	if n := counting.lists.Load(); n != 4 {
+		t.Errorf("expected 4 reads, got %d", n)
+	}
+}
+
+func TestStore_CoalescesMisses(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newTestStore(t, 1)
+	counting.release = make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{})
+		}()
+	}
+	for counting.counts.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond) // let the others join
+	close(counting.release)
+	wg.Wait()
+
+	if n := counting.counts.Load(); n != 1 {
+		t.Errorf("expected the misses to share a single read, got %d", n)
+	}
+}
+
+func TestStore_InvalidationDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	s, counting := newTestStore(t, 1)
+	counting.release = make(chan struct{})
+
+	done := make(chan uint64)
+	go func() {
+		count, _ := s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{})
+		done <- count
+	}()
+	for counting.counts.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// the like lands after the count was read but before the load caches it
+	like(t, s, "actor-00", "recipient")
+	close(counting.release)
+	if count := <-done; count != 0 {
+		t.Fatalf("expected the load to return the count it read, got %d", count)
+	}
+
+	if count, _ := s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{}); count != 1 {
+		t.Errorf("expected the stale count not to be cached, got %d", count)
+	}
+	if n := counting.counts.Load(); n != 2 {
+		t.Errorf("expected the count to be read again, got %d reads", n)
+	}
+}
+
+func TestStore_DeactivateDropsEverything(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(t, 1)
+	like(t, s, "actor-00", "recipient")
+	_, _ = s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{})
+
+	// deactivating the liker changes the recipient's count without a decision aimed at them
+	if _, err := s.SetUserActive(ctx, "actor-00", false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count, _ := s.CountLikedYou(ctx, "recipient", dataaccess.TimeWindow{}); count != 0 {
+		t.Errorf("expected 0 after deactivation, got %d", count)
+	}
+}
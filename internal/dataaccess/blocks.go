@@ -0,0 +1,89 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+// BlockUser records that blockerID blocked blockedID. Blocking twice keeps
+// the original block time. Both users' counters are recomputed in the same
+// transaction.
+func (r *Repository) BlockUser(ctx context.Context, blockerID, blockedID string) error {
+	const query = `
+		INSERT INTO blocks (blocker_id, blocked_id)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE blocker_id = blocker_id;
+	`
+
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, query, blockerID, blockedID); err != nil {
+			if isMySQLError(err, errForeignKeyFailed) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("error blocking user: %w", err)
+		}
+		return refreshLikedYouCounts(ctx, tx, []string{blockerID, blockedID})
+	})
+}
+
+// UnblockUser removes the block, if any, that blockerID placed on blockedID.
+func (r *Repository) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	const query = `
+		DELETE FROM blocks WHERE blocker_id = ? AND blocked_id = ?;
+	`
+
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, query, blockerID, blockedID); err != nil {
+			return fmt.Errorf("error unblocking user: %w", err)
+		}
+		return refreshLikedYouCounts(ctx, tx, []string{blockerID, blockedID})
+	})
+}
+
+func (r *Repository) ListBlockedUsers(
+	ctx context.Context,
+	blockerID string,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]Block, error) {
+	query, args := buildListBlockedUsersQuery(blockerID, cursor, pageSize)
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []Block
+	for rows.Next() {
+		var b Block
+		if err := rows.Scan(&b.BlockedID, &b.CreatedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func buildListBlockedUsersQuery(blockerID string, cursor pagination.Cursor, pageSize int) (string, []interface{}) {
+	query := `
+		SELECT blocked_id, UNIX_TIMESTAMP(created_at)
+		FROM blocks
+		WHERE blocker_id = ?
+	`
+	args := []interface{}{blockerID}
+
+	query, args = appendSeekPredicate(query, args, "created_at", "blocked_id", cursor)
+
+	query += " ORDER BY created_at DESC, blocked_id DESC LIMIT ?;"
+	args = append(args, pageSize+1) // +1 to check for next page
+
+	return query, args
+}
@@ -1,18 +1,52 @@
 package dataaccess
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// CountLikedYou reads the recipient's maintained counter, recipients without
+// a counter have never been liked. The counters only cover all time, counts
+// within a window are computed from decisions using idx_recipient_liked.
+func (r *Repository) CountLikedYou(ctx context.Context, recipientID string, window TimeWindow) (uint64, error) {
+	if !window.IsZero() {
+		return r.countLikedYouInWindow(ctx, recipientID, window)
+	}
 
-func (r *Repository) CountLikedYou(ctx context.Context, recipientID string) (uint64, error) {
 	const query = `
-        SELECT COUNT(*) s
-        FROM decisions 
-        WHERE recipient_id = ? AND liked = TRUE;
-    `
+		SELECT GREATEST(liked_count, 0) FROM liked_you_counts WHERE recipient_id = ?;
+	`
 
 	var count uint64
-	err := r.db.QueryRowContext(ctx, query, recipientID).Scan(&count)
+	err := r.reader(ctx).QueryRowContext(ctx, query, recipientID).Scan(&count)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *Repository) countLikedYouInWindow(ctx context.Context, recipientID string, window TimeWindow) (uint64, error) {
+	query, args := buildCountLikedYouQuery(recipientID, window)
+
+	var count uint64
+	if err := r.reader(ctx).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
 		return 0, err
 	}
 	return count, nil
 }
+
+func buildCountLikedYouQuery(recipientID string, window TimeWindow) (string, []interface{}) {
+	query := `
+		SELECT COUNT(*)
+		FROM decisions
+		WHERE recipient_id = ? AND liked = TRUE
+	`
+	query += hiddenPairFilter("decisions.actor_id", "decisions.recipient_id")
+	args := []interface{}{recipientID}
+
+	return appendTimeWindow(query, args, "updated_at", window)
+}
@@ -1,46 +1,154 @@
 package dataaccess
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"os"
+	"strings"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/jacob-alt-del/explore-service/internal/migrations"
 )
 
 type Repository struct {
+	// db is the primary, used for every write and for reads that must see them
 	db *sql.DB
+	// replicas serve lag tolerant reads, nil without replicas
+	replicas *replicaPool
+	// outboxDisabled skips the outbox writes when no relay publishes them
+	outboxDisabled bool
 }
 
-func NewRepository(db *sql.DB) *Repository {
-	return &Repository{db: db}
+// querier is satisfied by both *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// NewRepository returns a Repository writing to db, reads listed in reader
+// are spread over the optional read replicas.
+func NewRepository(db *sql.DB, replicas ...*sql.DB) *Repository {
+	r := &Repository{db: db}
+	if len(replicas) > 0 {
+		r.replicas = newReplicaPool(replicas)
+	}
+	return r
 }
 
 func (r Repository) Close() {
+	if r.replicas != nil {
+		r.replicas.close()
+	}
 	r.db.Close()
 }
 
-func SetupRepository(user, password, host, name string) (*Repository, error) {
-	cfg := mysql.NewConfig()
-	cfg.User = user
-	cfg.Passwd = password
-	cfg.Net = "tcp"
-	cfg.Addr = host
-	cfg.DBName = name
-	cfg.ParseTime = true
+type Config struct {
+	User     string
+	Password string
+	Host     string
+	Name     string
+
+	// ReplicaDSNs are the data source names of the read replicas, optional.
+	ReplicaDSNs []string
+
+	// Migrate applies any pending schema migrations before returning.
+	Migrate bool
+}
+
+// ConfigFromEnv reads the DB_* environment variables, falling back to the
+// local docker-compose defaults.
+func ConfigFromEnv() Config {
+	return Config{
+		User:     getEnv("DB_USER", "root"),
+		Password: getEnv("DB_PASSWORD", "secret"),
+		Host:     getEnv("DB_HOST", "localhost:3306"),
+		Name:     getEnv("DB_NAME", "explore"),
+		Migrate:  os.Getenv("DB_MIGRATE") == "true",
+
+		ReplicaDSNs: splitList(os.Getenv("DB_REPLICA_DSNS")),
+	}
+}
+
+// splitList splits a comma separated list, dropping empty entries.
+func splitList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
 
-	var err error
-	db, err := sql.Open("mysql", cfg.FormatDSN())
+func OpenDB(cfg Config) (*sql.DB, error) {
+	mysqlCfg := mysql.NewConfig()
+	mysqlCfg.User = cfg.User
+	mysqlCfg.Passwd = cfg.Password
+	mysqlCfg.Net = "tcp"
+	mysqlCfg.Addr = cfg.Host
+	mysqlCfg.DBName = cfg.Name
+	mysqlCfg.ParseTime = true
+
+	db, err := sql.Open("mysql", mysqlCfg.FormatDSN())
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
 	}
 
-	err = db.Ping()
+	return db, nil
+}
+
+// openReplica opens a read replica without pinging it, a replica that is
+// down is skipped by the health checks instead of failing startup.
+func openReplica(dsn string) (*sql.DB, error) {
+	mysqlCfg, err := mysql.ParseDSN(dsn)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	mysqlCfg.ParseTime = true
+	return sql.Open("mysql", mysqlCfg.FormatDSN())
+}
+
+func SetupRepository(cfg Config) (*Repository, error) {
+	db, err := OpenDB(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %w", err)
+	}
+
+	if cfg.Migrate {
+		if err := migrations.NewMigrator(db).Up(context.Background()); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("error migrating database: %w", err)
+		}
+	}
+
+	replicas := make([]*sql.DB, 0, len(cfg.ReplicaDSNs))
+	for i, dsn := range cfg.ReplicaDSNs {
+		replica, err := openReplica(dsn)
+		if err != nil {
+			for _, r := range replicas {
+				r.Close()
+			}
+			db.Close()
+			return nil, fmt.Errorf("error opening read replica %d: %w", i, err)
+		}
+		replicas = append(replicas, replica)
 	}
 
-	repo := NewRepository(db)
+	repo := NewRepository(db, replicas...)
 
 	fmt.Println("Database connected!")
 
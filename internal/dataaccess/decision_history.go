@@ -0,0 +1,50 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListDecisionHistory returns one page (plus one extra row to detect a next
+// page) of every decision actorID made about recipientID, newest first.
+// Events are ordered by their auto increment id, beforeID resumes after the
+// last event of the previous page and is 0 for the first page.
+func (r *Repository) ListDecisionHistory(
+	ctx context.Context,
+	actorID, recipientID string,
+	beforeID int64,
+	pageSize int,
+) ([]DecisionEvent, error) {
+	query := `
+		SELECT id, actor_id, recipient_id, liked, UNIX_TIMESTAMP(created_at)
+		FROM decision_events
+		WHERE actor_id = ? AND recipient_id = ?
+	`
+	args := []interface{}{actorID, recipientID}
+	if beforeID > 0 {
+		query += " AND id < ?"
+		args = append(args, beforeID)
+	}
+	query += " ORDER BY id DESC LIMIT ?;"
+	args = append(args, pageSize+1) // +1 to check for next page
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing decision history: %w", err)
+	}
+	defer rows.Close()
+
+	var results []DecisionEvent
+	for rows.Next() {
+		var e DecisionEvent
+		if err := rows.Scan(&e.ID, &e.ActorID, &e.RecipientID, &e.Liked, &e.CreatedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
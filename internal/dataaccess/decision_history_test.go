@@ -0,0 +1,36 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_ListDecisionHistory_BeforeID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery("SELECT id, actor_id, recipient_id, liked, UNIX_TIMESTAMP\\(created_at\\)\\s+FROM decision_events\\s+WHERE actor_id = \\? AND recipient_id = \\?\\s+AND id < \\? ORDER BY id DESC LIMIT \\?").
+		WithArgs("actor1", "recipient1", int64(10), 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "actor_id", "recipient_id", "liked", "created_at"}).
+			AddRow(int64(9), "actor1", "recipient1", false, int64(1730000200)).
+			AddRow(int64(4), "actor1", "recipient1", true, int64(1730000100)))
+
+	events, err := repo.ListDecisionHistory(context.Background(), "actor1", "recipient1", 10, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 2 || events[0].ID != 9 || events[0].Liked || !events[1].Liked {
+		t.Errorf("unexpected events: %+v", events)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
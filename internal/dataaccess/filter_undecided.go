@@ -0,0 +1,66 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FilterUndecided returns the candidates actorID has not decided on, in
+// candidate order. With passExpiry set, passes older than passExpiry count as
+// undecided so those users can be shown again, likes never expire. It reads
+// from the primary since the deck is usually refilled right after a swipe.
+func (r *Repository) FilterUndecided(ctx context.Context, actorID string, candidateIDs []string, passExpiry time.Duration) ([]string, error) {
+	if len(candidateIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT recipient_id FROM decisions
+		WHERE actor_id = ? AND recipient_id IN (?` + strings.Repeat(", ?", len(candidateIDs)-1) + `)
+	`
+	args := make([]interface{}, 0, len(candidateIDs)+2)
+	args = append(args, actorID)
+	for _, id := range candidateIDs {
+		args = append(args, id)
+	}
+	if passExpiry > 0 {
+		// the cutoff uses the database clock, the same that wrote updated_at
+		query += " AND (liked = TRUE OR updated_at >= NOW() - INTERVAL ? SECOND)"
+		args = append(args, int64(passExpiry/time.Second))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("error filtering candidates: %w", err)
+	}
+	defer rows.Close()
+
+	decided := map[string]bool{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		decided[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return undecided(candidateIDs, decided), nil
+}
+
+// undecided keeps the candidates missing from decided, in order and without duplicates.
+func undecided(candidateIDs []string, decided map[string]bool) []string {
+	results := make([]string, 0, len(candidateIDs))
+	for _, id := range candidateIDs {
+		if decided[id] {
+			continue
+		}
+		decided[id] = true // drop later duplicates
+		results = append(results, id)
+	}
+	return results
+}
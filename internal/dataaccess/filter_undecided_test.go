@@ -0,0 +1,36 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_FilterUndecided_SingleQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT recipient_id FROM decisions\s+WHERE actor_id = \? AND recipient_id IN \(\?, \?, \?\)\s+AND \(liked = TRUE OR updated_at >= NOW\(\) - INTERVAL \? SECOND\)`).
+		WithArgs("actor1", "c1", "c2", "c3", int64(86400)).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("c2"))
+
+	got, err := repo.FilterUndecided(context.Background(), "actor1", []string{"c1", "c2", "c3"}, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(got) != "[c1 c3]" {
+		t.Errorf("expected [c1 c3], got %v", got)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
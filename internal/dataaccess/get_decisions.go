@@ -0,0 +1,76 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetDecisions returns the current decision in both directions of every pair,
+// in pair order, with a single primary key lookup. The incoming decision is
+// left out while the pair is hidden from the actor, the same way it is hidden
+// from their liked you lists, so it can't reveal a block or an unmatch. It
+// reads from the primary since clients check a decision right after making it.
+func (r *Repository) GetDecisions(ctx context.Context, pairs []DecisionPair) ([]PairDecisions, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	// both directions of every pair, without duplicates
+	keys := make([]pairKey, 0, len(pairs)*2)
+	seen := map[pairKey]bool{}
+	for _, p := range pairs {
+		for _, k := range []pairKey{
+			{actorID: p.ActorID, recipientID: p.RecipientID},
+			{actorID: p.RecipientID, recipientID: p.ActorID},
+		} {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	query := `
+		SELECT actor_id, recipient_id, liked, UNIX_TIMESTAMP(created_at), UNIX_TIMESTAMP(updated_at),
+			(TRUE ` + hiddenPairFilter("decisions.actor_id", "decisions.recipient_id") + `) AS visible
+		FROM decisions
+		WHERE (actor_id, recipient_id) IN ((?, ?)` + strings.Repeat(", (?, ?)", len(keys)-1) + `);
+	`
+	args := make([]interface{}, 0, len(keys)*2)
+	for _, k := range keys {
+		args = append(args, k.actorID, k.recipientID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting decisions: %w", err)
+	}
+	defer rows.Close()
+
+	found := map[pairKey]*DecisionRecord{}
+	visible := map[pairKey]bool{}
+	for rows.Next() {
+		var d DecisionRecord
+		var v bool
+		if err := rows.Scan(&d.ActorID, &d.RecipientID, &d.Liked, &d.CreatedAtUnix, &d.UpdatedAtUnix, &v); err != nil {
+			return nil, err
+		}
+		k := pairKey{actorID: d.ActorID, recipientID: d.RecipientID}
+		found[k] = &d
+		visible[k] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]PairDecisions, len(pairs))
+	for i, p := range pairs {
+		results[i].Outgoing = found[pairKey{actorID: p.ActorID, recipientID: p.RecipientID}]
+		incoming := pairKey{actorID: p.RecipientID, recipientID: p.ActorID}
+		if visible[incoming] {
+			results[i].Incoming = found[incoming]
+		}
+	}
+	return results, nil
+}
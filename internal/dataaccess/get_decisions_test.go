@@ -0,0 +1,56 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_GetDecisions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// both directions of every pair, the repeated b/a pair only once
+	mock.ExpectQuery(`FROM decisions\s+WHERE \(actor_id, recipient_id\) IN \(\(\?, \?\), \(\?, \?\), \(\?, \?\), \(\?, \?\)\)`).
+		WithArgs("a", "b", "b", "a", "b", "c", "c", "b").
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id", "recipient_id", "liked", "created_at", "updated_at", "visible"}).
+			AddRow("a", "b", false, 100, 200, true).
+			AddRow("b", "a", true, 150, 150, true).
+			AddRow("c", "b", true, 300, 300, false))
+
+	got, err := repo.GetDecisions(context.Background(), []DecisionPair{
+		{ActorID: "a", RecipientID: "b"},
+		{ActorID: "b", RecipientID: "c"},
+		{ActorID: "b", RecipientID: "a"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(got))
+	}
+
+	if out := got[0].Outgoing; out == nil || out.Liked || out.CreatedAtUnix != 100 || out.UpdatedAtUnix != 200 {
+		t.Errorf("unexpected outgoing decision %+v", out)
+	}
+	if in := got[0].Incoming; in == nil || !in.Liked {
+		t.Errorf("unexpected incoming decision %+v", in)
+	}
+	// c's like is hidden from b
+	if got[1].Outgoing != nil || got[1].Incoming != nil {
+		t.Errorf("expected no visible decisions, got %+v", got[1])
+	}
+	if got[2].Outgoing == nil || !got[2].Outgoing.Liked || got[2].Incoming == nil {
+		t.Errorf("unexpected decisions %+v", got[2])
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// refreshBatchSize caps the number of recipients recomputed per statement.
+const refreshBatchSize = 500
+
+// countLikedYouSubquery counts the visible likes received by recipientCol,
+// it is the source of truth the liked_you_counts counters are derived from.
+func countLikedYouSubquery(recipientCol string) string {
+	return `(
+		SELECT COUNT(*) FROM decisions
+		WHERE decisions.recipient_id = ` + recipientCol + ` AND decisions.liked = TRUE
+	` + hiddenPairFilter("decisions.actor_id", "decisions.recipient_id") + `
+	)`
+}
+
+// applyLikedYouDeltas adds the per recipient deltas to their counters.
+func applyLikedYouDeltas(ctx context.Context, tx *sql.Tx, deltas map[string]int64) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO liked_you_counts (recipient_id, liked_count)
+		VALUES (?, ?)` + strings.Repeat(", (?, ?)", len(deltas)-1) + `
+		ON DUPLICATE KEY UPDATE liked_count = liked_count + VALUES(liked_count);
+	`
+	args := make([]interface{}, 0, len(deltas)*2)
+	for _, id := range slices.Sorted(maps.Keys(deltas)) {
+		args = append(args, id, deltas[id])
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("error updating liked you counts: %w", err)
+	}
+	return nil
+}
+
+// refreshLikedYouCounts recomputes the counters of recipientIDs from
+// decisions. Used when a block, unmatch or (de)activation changes which
+// existing likes are visible, a plain delta can't express those.
+func refreshLikedYouCounts(ctx context.Context, tx *sql.Tx, recipientIDs []string) error {
+	for start := 0; start < len(recipientIDs); start += refreshBatchSize {
+		batch := recipientIDs[start:min(start+refreshBatchSize, len(recipientIDs))]
+
+		query := `
+			INSERT INTO liked_you_counts (recipient_id, liked_count)
+			SELECT u.id, ` + countLikedYouSubquery("u.id") + `
+			FROM users AS u
+			WHERE u.id IN (?` + strings.Repeat(", ?", len(batch)-1) + `)
+			ON DUPLICATE KEY UPDATE liked_count = VALUES(liked_count);
+		`
+		args := make([]interface{}, 0, len(batch))
+		for _, id := range batch {
+			args = append(args, id)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("error refreshing liked you counts: %w", err)
+		}
+	}
+	return nil
+}
+
+// likedRecipients returns the users actorID currently likes, whose counters
+// depend on actorID's visibility.
+func likedRecipients(ctx context.Context, tx *sql.Tx, actorID string) ([]string, error) {
+	const query = `
+		SELECT recipient_id FROM decisions WHERE actor_id = ? AND liked = TRUE;
+	`
+
+	rows, err := tx.QueryContext(ctx, query, actorID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing liked recipients: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ReconcileLikedYouCounts walks every user in batches of batchSize, compares
+// their counter with the visible likes in decisions and recomputes the ones
+// that drifted. It returns how many counters were fixed.
+func (r *Repository) ReconcileLikedYouCounts(ctx context.Context, batchSize int) (int, error) {
+	const query = `
+		SELECT u.id, COALESCE(c.liked_count, 0), %s
+		FROM users AS u
+		LEFT JOIN liked_you_counts AS c ON c.recipient_id = u.id
+		WHERE u.id > ?
+		ORDER BY u.id
+		LIMIT ?;
+	`
+	batchQuery := fmt.Sprintf(query, countLikedYouSubquery("u.id"))
+
+	fixed := 0
+	after := ""
+	for {
+		drifted, last, n, err := r.findDriftedCounts(ctx, batchQuery, after, batchSize)
+		if err != nil {
+			return fixed, err
+		}
+		if len(drifted) > 0 {
+			err := r.withTx(ctx, func(tx *sql.Tx) error {
+				return refreshLikedYouCounts(ctx, tx, drifted)
+			})
+			if err != nil {
+				return fixed, err
+			}
+			fixed += len(drifted)
+		}
+		if n < batchSize {
+			return fixed, nil
+		}
+		after = last
+	}
+}
+
+// findDriftedCounts reads one batch of users after the given id, returning
+// the ids whose counter is wrong, the last id read and the batch length.
+func (r *Repository) findDriftedCounts(ctx context.Context, query, after string, batchSize int) ([]string, string, int, error) {
+	rows, err := r.db.QueryContext(ctx, query, after, batchSize)
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("error reading liked you counts: %w", err)
+	}
+	defer rows.Close()
+
+	var drifted []string
+	var last string
+	n := 0
+	for rows.Next() {
+		var stored, actual int64
+		if err := rows.Scan(&last, &stored, &actual); err != nil {
+			return nil, "", 0, err
+		}
+		if stored != actual {
+			drifted = append(drifted, last)
+		}
+		n++
+	}
+	return drifted, last, n, rows.Err()
+}
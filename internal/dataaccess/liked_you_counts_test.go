@@ -0,0 +1,106 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_CountLikedYou_NoCounter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery("SELECT GREATEST\\(liked_count, 0\\) FROM liked_you_counts WHERE recipient_id = \\?").
+		WithArgs("recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"liked_count"}))
+
+	count, err := repo.CountLikedYou(context.Background(), "recipient1", TimeWindow{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected 0, got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_CountLikedYou_WindowSkipsCounter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// the counters only cover all time, a window counts the decisions directly
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM decisions\s+WHERE recipient_id = \? AND liked = TRUE.* AND updated_at >= FROM_UNIXTIME\(\?\) AND updated_at < FROM_UNIXTIME\(\?\)$`).
+		WithArgs("recipient1", int64(1730000000), int64(1730086400)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	window := TimeWindow{SinceUnix: 1730000000, UntilUnix: 1730086400}
+	count, err := repo.CountLikedYou(context.Background(), "recipient1", window)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 4 {
+		t.Errorf("expected 4, got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ReconcileLikedYouCounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// first batch is full, user-b drifted
+	mock.ExpectQuery("SELECT u.id, COALESCE\\(c.liked_count, 0\\)").
+		WithArgs("", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "stored", "actual"}).
+			AddRow("user-a", int64(3), int64(3)).
+			AddRow("user-b", int64(5), int64(4)))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO liked_you_counts .* SELECT u.id").
+		WithArgs("user-b").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	// the last batch is short, user-c has no counter yet
+	mock.ExpectQuery("SELECT u.id, COALESCE\\(c.liked_count, 0\\)").
+		WithArgs("user-b", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "stored", "actual"}).
+			AddRow("user-c", int64(0), int64(1)))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO liked_you_counts .* SELECT u.id").
+		WithArgs("user-c").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	fixed, err := repo.ReconcileLikedYouCounts(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fixed != 2 {
+		t.Errorf("expected 2 fixed counters, got %d", fixed)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+// MarkLikesSeen advances the recipient's seen watermark to upTo, every like at
+// or before upTo in (updated_at, actor_id) order counts as seen. The watermark
+// only moves forward, a single upsert compares it with the stored one under
+// the row lock so concurrent calls from several devices can't regress it.
+func (r *Repository) MarkLikesSeen(ctx context.Context, recipientID string, upTo pagination.Cursor) error {
+	// seen_actor_id is assigned first so it is compared with the old seen_updated_at
+	const query = `
+		INSERT INTO liked_you_seen (recipient_id, seen_updated_at, seen_actor_id)
+		VALUES (?, FROM_UNIXTIME(?), ?)
+		ON DUPLICATE KEY UPDATE
+			seen_actor_id = IF((VALUES(seen_updated_at), VALUES(seen_actor_id)) > (seen_updated_at, seen_actor_id),
+				VALUES(seen_actor_id), seen_actor_id),
+			seen_updated_at = GREATEST(seen_updated_at, VALUES(seen_updated_at));
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, recipientID, upTo.UnixTs, upTo.ID); err != nil {
+		if isMySQLError(err, errForeignKeyFailed) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("error marking likes seen: %w", err)
+	}
+	return nil
+}
+
+// GetLikesSeen returns the recipient's seen watermark, the zero cursor if they
+// have never marked likes seen. It reads from the primary so a badge refreshed
+// right after MarkLikesSeen is already cleared.
+func (r *Repository) GetLikesSeen(ctx context.Context, recipientID string) (pagination.Cursor, error) {
+	const query = `
+		SELECT UNIX_TIMESTAMP(seen_updated_at), seen_actor_id FROM liked_you_seen WHERE recipient_id = ?;
+	`
+
+	var c pagination.Cursor
+	err := r.db.QueryRowContext(ctx, query, recipientID).Scan(&c.UnixTs, &c.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pagination.Cursor{}, nil
+		}
+		return pagination.Cursor{}, fmt.Errorf("error reading seen watermark: %w", err)
+	}
+	return c, nil
+}
+
+// CountUnseenLikedYou counts the visible likes newer than the recipient's seen
+// watermark, all of them when there is none. Like GetLikesSeen it reads from
+// the primary.
+func (r *Repository) CountUnseenLikedYou(ctx context.Context, recipientID string) (uint64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM decisions
+		LEFT JOIN liked_you_seen AS s ON s.recipient_id = decisions.recipient_id
+		WHERE decisions.recipient_id = ? AND decisions.liked = TRUE
+		  AND (
+			s.recipient_id IS NULL
+			OR decisions.updated_at > s.seen_updated_at
+			OR (decisions.updated_at = s.seen_updated_at AND decisions.actor_id > s.seen_actor_id)
+		  )
+	` + hiddenPairFilter("decisions.actor_id", "decisions.recipient_id")
+
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, query, recipientID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting unseen likes: %w", err)
+	}
+	return count, nil
+}
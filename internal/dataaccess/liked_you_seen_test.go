@@ -0,0 +1,58 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+func Test_MarkLikesSeen_OnlyMovesForward(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// the comparison happens inside the upsert, no read-modify-write round trip
+	mock.ExpectExec(`INSERT INTO liked_you_seen .* ON DUPLICATE KEY UPDATE\s+seen_actor_id = IF\(.*\),\s+seen_updated_at = GREATEST\(seen_updated_at, VALUES\(seen_updated_at\)\)`).
+		WithArgs("user1", int64(1730000000), "actor1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := repo.MarkLikesSeen(context.Background(), "user1", pagination.Cursor{UnixTs: 1730000000, ID: "actor1"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_CountUnseenLikedYou(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM decisions\s+LEFT JOIN liked_you_seen`).
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := repo.CountUnseenLikedYou(context.Background(), "user1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3, got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
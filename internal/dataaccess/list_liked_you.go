@@ -1,16 +1,22 @@
 package dataaccess
 
-import "context"
+import (
+	"context"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
 
 func (r *Repository) ListLikedYou(
 	ctx context.Context,
 	recipientID string,
-	paginationUnix int64,
+	order LikedYouOrder,
+	window TimeWindow,
+	cursor pagination.Cursor,
 	pageSize int,
 ) ([]Decision, error) {
-	query, args := buildListLikedYouQuery(recipientID, paginationUnix, pageSize)
+	query, args := buildListLikedYouQuery(recipientID, order, window, cursor, pageSize)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +25,7 @@ func (r *Repository) ListLikedYou(
 	var results []Decision
 	for rows.Next() {
 		var d Decision
-		if err := rows.Scan(&d.ActorID, &d.UpdatedAtUnix); err != nil {
+		if err := rows.Scan(&d.ActorID, &d.CreatedAtUnix, &d.UpdatedAtUnix); err != nil {
 			return nil, err
 		}
 		results = append(results, d)
@@ -31,21 +37,81 @@ func (r *Repository) ListLikedYou(
 	return results, nil
 }
 
-func buildListLikedYouQuery(recipientID string, paginationUnix int64, pageSize int) (string, []interface{}) {
+func buildListLikedYouQuery(recipientID string, order LikedYouOrder, window TimeWindow, cursor pagination.Cursor, pageSize int) (string, []interface{}) {
 	query := `
-        SELECT actor_id, UNIX_TIMESTAMP(updated_at)
+        SELECT actor_id, UNIX_TIMESTAMP(created_at), UNIX_TIMESTAMP(updated_at)
         FROM decisions
         WHERE recipient_id = ? AND liked = TRUE
     `
+	query += hiddenPairFilter("decisions.actor_id", "decisions.recipient_id")
 	args := []interface{}{recipientID}
 
-	if paginationUnix > 0 {
-		query += " AND updated_at < FROM_UNIXTIME(?)"
-		args = append(args, paginationUnix)
-	}
+	query, args = appendTimeWindow(query, args, "updated_at", window)
+	query, args = appendLikedYouOrder(query, args, "", order, cursor)
 
-	query += " ORDER BY updated_at DESC LIMIT ?"
+	query += " LIMIT ?"
 	args = append(args, pageSize+1) // +1 to check for next page
 
 	return query, args
 }
+
+// appendLikedYouOrder appends the seek predicate and ORDER BY clause of order,
+// prefix qualifies the decisions columns, e.g. "d1.".
+func appendLikedYouOrder(query string, args []interface{}, prefix string, order LikedYouOrder, cursor pagination.Cursor) (string, []interface{}) {
+	tsColumn := prefix + "created_at"
+	if order == LikedYouRecentActivity {
+		tsColumn = prefix + "updated_at"
+	}
+	idColumn := prefix + "actor_id"
+
+	if order == LikedYouOldestFirst {
+		query, args = appendAscendingSeekPredicate(query, args, tsColumn, idColumn, cursor)
+		return query + " ORDER BY " + tsColumn + " ASC, " + idColumn + " ASC", args
+	}
+	query, args = appendSeekPredicate(query, args, tsColumn, idColumn, cursor)
+	return query + " ORDER BY " + tsColumn + " DESC, " + idColumn + " DESC", args
+}
+
+// appendTimeWindow restricts a query to the rows whose tsColumn falls inside
+// window. The liked you queries always pass updated_at, see TimeWindow.
+func appendTimeWindow(query string, args []interface{}, tsColumn string, window TimeWindow) (string, []interface{}) {
+	if window.SinceUnix != 0 {
+		query += " AND " + tsColumn + " >= FROM_UNIXTIME(?)"
+		args = append(args, window.SinceUnix)
+	}
+	if window.UntilUnix != 0 {
+		query += " AND " + tsColumn + " < FROM_UNIXTIME(?)"
+		args = append(args, window.UntilUnix)
+	}
+	return query, args
+}
+
+// appendSeekPredicate restricts a query ordered by (tsColumn DESC, idColumn DESC)
+// to the rows after cursor.
+func appendSeekPredicate(query string, args []interface{}, tsColumn, idColumn string, cursor pagination.Cursor) (string, []interface{}) {
+	switch {
+	case cursor.IsZero():
+	case cursor.IsLegacy():
+		query += " AND " + tsColumn + " < FROM_UNIXTIME(?)"
+		args = append(args, cursor.UnixTs)
+	default:
+		query += " AND (" + tsColumn + " < FROM_UNIXTIME(?) OR (" + tsColumn + " = FROM_UNIXTIME(?) AND " + idColumn + " < ?))"
+		args = append(args, cursor.UnixTs, cursor.UnixTs, cursor.ID)
+	}
+	return query, args
+}
+
+// appendAscendingSeekPredicate is appendSeekPredicate for a query ordered by
+// (tsColumn ASC, idColumn ASC).
+func appendAscendingSeekPredicate(query string, args []interface{}, tsColumn, idColumn string, cursor pagination.Cursor) (string, []interface{}) {
+	switch {
+	case cursor.IsZero():
+	case cursor.IsLegacy():
+		query += " AND " + tsColumn + " > FROM_UNIXTIME(?)"
+		args = append(args, cursor.UnixTs)
+	default:
+		query += " AND (" + tsColumn + " > FROM_UNIXTIME(?) OR (" + tsColumn + " = FROM_UNIXTIME(?) AND " + idColumn + " > ?))"
+		args = append(args, cursor.UnixTs, cursor.UnixTs, cursor.ID)
+	}
+	return query, args
+}
@@ -0,0 +1,160 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+func Test_ListLikedYou_CompositeCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	rows := sqlmock.NewRows([]string{"actor_id", "created_at", "updated_at"}).
+		AddRow("actor2", int64(1720000000), int64(1730000000)).
+		AddRow("actor1", int64(1720000000), int64(1730000000))
+
+	// rows sharing the cursor's second must be kept when their actor id sorts after it
+	mock.ExpectQuery(`updated_at < FROM_UNIXTIME\(\?\) OR \(updated_at = FROM_UNIXTIME\(\?\) AND actor_id < \?\)\) ORDER BY updated_at DESC, actor_id DESC`).
+		WithArgs("recipient1", int64(1730000000), int64(1730000000), "actor3", 3).
+		WillReturnRows(rows)
+
+	cursor := pagination.Cursor{UnixTs: 1730000000, ID: "actor3"}
+	decisions, err := repo.ListLikedYou(context.Background(), "recipient1", LikedYouRecentActivity, TimeWindow{}, cursor, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(decisions) != 2 {
+		t.Errorf("expected 2 decisions, got %d", len(decisions))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ListNewLikedYou_LegacyCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`AND d1.updated_at < FROM_UNIXTIME\(\?\) ORDER BY d1.updated_at DESC, d1.actor_id DESC`).
+		WithArgs("recipient1", int64(1730000000), 6).
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id", "created_at", "updated_at"}))
+
+	cursor := pagination.Cursor{UnixTs: 1730000000}
+	if _, err := repo.ListNewLikedYou(context.Background(), "recipient1", LikedYouRecentActivity, TimeWindow{}, cursor, 5); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ListLikedYou_Orders(t *testing.T) {
+	tests := []struct {
+		name      string
+		order     LikedYouOrder
+		wantQuery string
+	}{
+		{
+			name:      "first liked",
+			order:     LikedYouFirstLiked,
+			wantQuery: `\(created_at < FROM_UNIXTIME\(\?\) OR \(created_at = FROM_UNIXTIME\(\?\) AND actor_id < \?\)\) ORDER BY created_at DESC, actor_id DESC LIMIT \?`,
+		},
+		{
+			name:      "oldest first",
+			order:     LikedYouOldestFirst,
+			wantQuery: `\(created_at > FROM_UNIXTIME\(\?\) OR \(created_at = FROM_UNIXTIME\(\?\) AND actor_id > \?\)\) ORDER BY created_at ASC, actor_id ASC LIMIT \?`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to open sqlmock database: %v", err)
+			}
+			defer db.Close()
+
+			repo := NewRepository(db)
+
+			mock.ExpectQuery(tt.wantQuery).
+				WithArgs("recipient1", int64(1730000000), int64(1730000000), "actor3", 6).
+				WillReturnRows(sqlmock.NewRows([]string{"actor_id", "created_at", "updated_at"}))
+
+			cursor := pagination.Cursor{UnixTs: 1730000000, ID: "actor3"}
+			if _, err := repo.ListLikedYou(context.Background(), "recipient1", tt.order, TimeWindow{}, cursor, 5); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func Test_ListNewLikedYou_TimeWindow(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`AND d1.updated_at >= FROM_UNIXTIME\(\?\) ORDER BY d1.created_at DESC, d1.actor_id DESC LIMIT \?`).
+		WithArgs("recipient1", int64(1730000000), 6).
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id", "created_at", "updated_at"}))
+
+	window := TimeWindow{SinceUnix: 1730000000}
+	if _, err := repo.ListNewLikedYou(context.Background(), "recipient1", LikedYouFirstLiked, window, pagination.Cursor{}, 5); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ListLikedYou_FirstLikedTimeWindow(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// the window bounds the latest like while the page is ordered by the first one
+	mock.ExpectQuery(`AND updated_at >= FROM_UNIXTIME\(\?\) AND updated_at < FROM_UNIXTIME\(\?\) ORDER BY created_at DESC, actor_id DESC LIMIT \?`).
+		WithArgs("recipient1", int64(1730000000), int64(1730086400), 6).
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id", "created_at", "updated_at"}).
+			AddRow("actor1", int64(1729990000), int64(1730000100)))
+
+	window := TimeWindow{SinceUnix: 1730000000, UntilUnix: 1730086400}
+	decisions, err := repo.ListLikedYou(context.Background(), "recipient1", LikedYouFirstLiked, window, pagination.Cursor{}, 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(decisions) != 1 || decisions[0].CreatedAtUnix != 1729990000 {
+		t.Errorf("unexpected decisions: %+v", decisions)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
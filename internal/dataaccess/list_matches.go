@@ -0,0 +1,107 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+func (r *Repository) ListMatches(
+	ctx context.Context,
+	userID string,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]Match, error) {
+	query, args := buildListMatchesQuery(userID, cursor, pageSize)
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []Match
+	for rows.Next() {
+		var m Match
+		if err := rows.Scan(&m.ID, &m.UserID, &m.MatchedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// buildListMatchesQuery looks the user up on each side of the pair separately
+// so both halves can use their (user, matched_at) index.
+func buildListMatchesQuery(userID string, cursor pagination.Cursor, pageSize int) (string, []interface{}) {
+	low := `
+		SELECT id, user_high_id AS user_id, matched_at
+		FROM matches
+		WHERE user_low_id = ? AND unmatched_at IS NULL
+	`
+	lowArgs := []interface{}{userID}
+	low, lowArgs = appendSeekPredicate(low, lowArgs, "matched_at", "id", cursor)
+
+	high := `
+		SELECT id, user_low_id AS user_id, matched_at
+		FROM matches
+		WHERE user_high_id = ? AND unmatched_at IS NULL
+	`
+	highArgs := []interface{}{userID}
+	high, highArgs = appendSeekPredicate(high, highArgs, "matched_at", "id", cursor)
+
+	query := "SELECT id, user_id, UNIX_TIMESTAMP(matched_at) FROM (" + low + " UNION ALL " + high + ") AS m" +
+		" ORDER BY matched_at DESC, id DESC LIMIT ?;"
+	args := append(lowArgs, highArgs...)
+	args = append(args, pageSize+1) // +1 to check for next page
+
+	return query, args
+}
+
+// createMatch records the match between actorID and recipientID unless the
+// pair already has one. It returns nil when no new match was created.
+func createMatch(ctx context.Context, tx *sql.Tx, actorID, recipientID string) (*Match, error) {
+	const insertQuery = `
+		INSERT INTO matches (id, user_low_id, user_high_id)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE id = id;
+	`
+	const matchedAtQuery = `
+		SELECT UNIX_TIMESTAMP(matched_at) FROM matches WHERE id = ?;
+	`
+
+	id := uuid.NewString()
+	low, high := orderedPair(actorID, recipientID)
+	res, err := tx.ExecContext(ctx, insertQuery, id, low, high)
+	if err != nil {
+		return nil, fmt.Errorf("error creating match: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if n != 1 {
+		return nil, nil // pair was already matched
+	}
+
+	m := &Match{ID: id, UserID: recipientID}
+	if err := tx.QueryRowContext(ctx, matchedAtQuery, id).Scan(&m.MatchedAtUnix); err != nil {
+		return nil, fmt.Errorf("error reading match: %w", err)
+	}
+	return m, nil
+}
+
+// orderedPair returns the two user ids in the canonical order used by the matches table.
+func orderedPair(a, b string) (string, string) {
+	if a < b {
+		return a, b
+	}
+	return b, a
+}
@@ -1,16 +1,22 @@
 package dataaccess
 
-import "context"
+import (
+	"context"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
 
 func (r *Repository) ListNewLikedYou(
 	ctx context.Context,
 	recipientID string,
-	paginationUnix int64,
+	order LikedYouOrder,
+	window TimeWindow,
+	cursor pagination.Cursor,
 	pageSize int,
 ) ([]Decision, error) {
-	query, args := buildListNewLikedYouQuery(recipientID, paginationUnix, pageSize)
+	query, args := buildListNewLikedYouQuery(recipientID, order, window, cursor, pageSize)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +25,7 @@ func (r *Repository) ListNewLikedYou(
 	var results []Decision
 	for rows.Next() {
 		var d Decision
-		if err := rows.Scan(&d.ActorID, &d.UpdatedAtUnix); err != nil {
+		if err := rows.Scan(&d.ActorID, &d.CreatedAtUnix, &d.UpdatedAtUnix); err != nil {
 			return nil, err
 		}
 		results = append(results, d)
@@ -31,9 +37,9 @@ func (r *Repository) ListNewLikedYou(
 	return results, nil
 }
 
-func buildListNewLikedYouQuery(recipientID string, paginationUnix int64, pageSize int) (string, []interface{}) {
+func buildListNewLikedYouQuery(recipientID string, order LikedYouOrder, window TimeWindow, cursor pagination.Cursor, pageSize int) (string, []interface{}) {
 	query := `
-		SELECT d1.actor_id, UNIX_TIMESTAMP(d1.updated_at) AS unix_timestamp
+		SELECT d1.actor_id, UNIX_TIMESTAMP(d1.created_at), UNIX_TIMESTAMP(d1.updated_at)
 		FROM decisions AS d1
 		LEFT JOIN decisions AS d2
 			ON d1.actor_id = d2.recipient_id
@@ -42,14 +48,13 @@ func buildListNewLikedYouQuery(recipientID string, paginationUnix int64, pageSiz
 		  AND d1.liked = TRUE
 		  AND (d2.liked IS NULL OR d2.liked = FALSE)
     `
+	query += hiddenPairFilter("d1.actor_id", "d1.recipient_id")
 	args := []interface{}{recipientID}
 
-	if paginationUnix > 0 {
-		query += " AND d1.updated_at < FROM_UNIXTIME(?)"
-		args = append(args, paginationUnix)
-	}
+	query, args = appendTimeWindow(query, args, "d1.updated_at", window)
+	query, args = appendLikedYouOrder(query, args, "d1.", order, cursor)
 
-	query += " ORDER BY d1.updated_at DESC LIMIT ?;"
+	query += " LIMIT ?;"
 	args = append(args, pageSize+1) // +1 to check for next page
 
 	return query, args
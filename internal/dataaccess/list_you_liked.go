@@ -0,0 +1,88 @@
+package dataaccess
+
+import (
+	"context"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+// ListYouLiked returns one page (plus one extra row to detect a next page)
+// of the users actorID liked, ordered by updated_at DESC, recipient_id DESC.
+func (r *Repository) ListYouLiked(
+	ctx context.Context,
+	actorID string,
+	filter YouLikedFilter,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]OutgoingLike, error) {
+	query, args := buildListYouLikedQuery(actorID, filter, cursor, pageSize)
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []OutgoingLike
+	for rows.Next() {
+		var l OutgoingLike
+		if err := rows.Scan(&l.RecipientID, &l.UpdatedAtUnix, &l.Matched); err != nil {
+			return nil, err
+		}
+		results = append(results, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Repository) CountYouLiked(ctx context.Context, actorID string, filter YouLikedFilter) (uint64, error) {
+	query := `
+		SELECT COUNT(*)
+	` + youLikedFrom + youLikedWhere(filter) + ";"
+
+	var count uint64
+	err := r.reader(ctx).QueryRowContext(ctx, query, actorID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// youLikedFrom joins each like with the pair's active match, if any.
+const youLikedFrom = `
+		FROM decisions AS d
+		LEFT JOIN matches AS m
+			ON m.user_low_id = LEAST(d.actor_id, d.recipient_id)
+			AND m.user_high_id = GREATEST(d.actor_id, d.recipient_id)
+			AND m.unmatched_at IS NULL
+	`
+
+func youLikedWhere(filter YouLikedFilter) string {
+	where := `
+		WHERE d.actor_id = ? AND d.liked = TRUE
+	` + outgoingHiddenFilter("d.actor_id", "d.recipient_id")
+	switch filter {
+	case YouLikedPending:
+		where += " AND m.id IS NULL"
+	case YouLikedMatched:
+		where += " AND m.id IS NOT NULL"
+	}
+	return where
+}
+
+func buildListYouLikedQuery(actorID string, filter YouLikedFilter, cursor pagination.Cursor, pageSize int) (string, []interface{}) {
+	query := `
+		SELECT d.recipient_id, UNIX_TIMESTAMP(d.updated_at), m.id IS NOT NULL
+	` + youLikedFrom + youLikedWhere(filter)
+	args := []interface{}{actorID}
+
+	query, args = appendSeekPredicate(query, args, "d.updated_at", "d.recipient_id", cursor)
+
+	query += " ORDER BY d.updated_at DESC, d.recipient_id DESC LIMIT ?;"
+	args = append(args, pageSize+1) // +1 to check for next page
+
+	return query, args
+}
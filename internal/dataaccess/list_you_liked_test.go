@@ -0,0 +1,39 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+func Test_ListYouLiked_Pending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	rows := sqlmock.NewRows([]string{"recipient_id", "unix_timestamp", "matched"}).
+		AddRow("recipient2", int64(1730000000), false)
+
+	mock.ExpectQuery(`WHERE d.actor_id = \? AND d.liked = TRUE .* AND m.id IS NULL AND \(d.updated_at < FROM_UNIXTIME\(\?\) OR .* ORDER BY d.updated_at DESC, d.recipient_id DESC LIMIT \?`).
+		WithArgs("actor1", int64(1730000000), int64(1730000000), "recipient3", 3).
+		WillReturnRows(rows)
+
+	cursor := pagination.Cursor{UnixTs: 1730000000, ID: "recipient3"}
+	likes, err := repo.ListYouLiked(context.Background(), "actor1", YouLikedPending, cursor, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(likes) != 1 || likes[0].RecipientID != "recipient2" || likes[0].Matched {
+		t.Errorf("unexpected likes: %+v", likes)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
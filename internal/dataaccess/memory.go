@@ -0,0 +1,1033 @@
+package dataaccess
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+type pairKey struct {
+	actorID     string
+	recipientID string
+}
+
+type memDecision struct {
+	liked     bool
+	createdAt int64
+	updatedAt int64
+}
+
+func (d *memDecision) record(actorID, recipientID string) *DecisionRecord {
+	return &DecisionRecord{
+		ActorID:       actorID,
+		RecipientID:   recipientID,
+		Liked:         d.liked,
+		CreatedAtUnix: d.createdAt,
+		UpdatedAtUnix: d.updatedAt,
+	}
+}
+
+type memUser struct {
+	username      string
+	createdAt     int64
+	deactivatedAt int64
+	erasedAt      int64
+}
+
+type memOutboxEvent struct {
+	OutboxEvent
+	lastError   string
+	availableAt time.Time
+}
+
+type memWebhookDelivery struct {
+	WebhookDelivery
+	availableAt time.Time
+}
+
+type memMatch struct {
+	id          string
+	matchedAt   int64
+	unmatchedAt int64
+	unmatchedBy string
+}
+
+// MemoryRepository is a concurrency-safe in-memory Store with the same
+// ordering, pagination and mutual-like semantics as the MySQL Repository.
+// Useful for running the server locally and for tests.
+type MemoryRepository struct {
+	mu        sync.RWMutex
+	users     map[string]*memUser
+	decisions map[pairKey]*memDecision
+	// events is the decision history of each pair, oldest first
+	events      map[pairKey][]DecisionEvent
+	lastEventID int64
+	// matches is keyed by the pair in orderedPair order
+	matches map[pairKey]*memMatch
+	// blocks maps blocker/blocked pairs to the unix time of the block
+	blocks map[pairKey]int64
+	// seen maps recipients to their seen watermark
+	seen map[string]pagination.Cursor
+	// erasures is the audit trail of EraseUserData calls
+	erasures []Erasure
+	// outbox holds the events not yet published, oldest first
+	outbox       []*memOutboxEvent
+	lastOutboxID int64
+	// webhookDeliveries is every delivery, oldest first
+	webhookDeliveries     []*memWebhookDelivery
+	lastWebhookDeliveryID int64
+	outboxDisabled        bool
+	now                   func() time.Time
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		users:     map[string]*memUser{},
+		decisions: map[pairKey]*memDecision{},
+		events:    map[pairKey][]DecisionEvent{},
+		matches:   map[pairKey]*memMatch{},
+		blocks:    map[pairKey]int64{},
+		seen:      map[string]pagination.Cursor{},
+		now:       time.Now,
+	}
+}
+
+func (m *MemoryRepository) ListLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order LikedYouOrder,
+	window TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]Decision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listLikers(recipientID, order, window, cursor, pageSize, func(actorID string) bool { return true }), nil
+}
+
+func (m *MemoryRepository) ListNewLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order LikedYouOrder,
+	window TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]Decision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listLikers(recipientID, order, window, cursor, pageSize, func(actorID string) bool {
+		d, ok := m.decisions[pairKey{actorID: recipientID, recipientID: actorID}]
+		return !ok || !d.liked
+	}), nil
+}
+
+func (m *MemoryRepository) CountLikedYou(ctx context.Context, recipientID string, window TimeWindow) (uint64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var count uint64
+	for k, d := range m.decisions {
+		if k.recipientID == recipientID && d.liked && !m.hidden(k.actorID, recipientID) && window.Contains(d.updatedAt) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *MemoryRepository) MarkLikesSeen(ctx context.Context, recipientID string, upTo pagination.Cursor) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.users[recipientID] == nil {
+		return ErrUserNotFound
+	}
+	if m.seen[recipientID].Less(upTo) {
+		m.seen[recipientID] = upTo
+	}
+	return nil
+}
+
+func (m *MemoryRepository) GetLikesSeen(ctx context.Context, recipientID string) (pagination.Cursor, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.seen[recipientID], nil
+}
+
+func (m *MemoryRepository) CountUnseenLikedYou(ctx context.Context, recipientID string) (uint64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	seen := m.seen[recipientID]
+	var count uint64
+	for k, d := range m.decisions {
+		if k.recipientID != recipientID || !d.liked || m.hidden(k.actorID, recipientID) {
+			continue
+		}
+		if seen.Less(pagination.Cursor{UnixTs: d.updatedAt, ID: k.actorID}) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *MemoryRepository) ListYouLiked(
+	ctx context.Context,
+	actorID string,
+	filter YouLikedFilter,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]OutgoingLike, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []OutgoingLike
+	for _, l := range m.outgoingLikes(actorID, filter) {
+		if afterCursor(l.UpdatedAtUnix, l.RecipientID, cursor) {
+			results = append(results, l)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].UpdatedAtUnix != results[j].UpdatedAtUnix {
+			return results[i].UpdatedAtUnix > results[j].UpdatedAtUnix
+		}
+		return results[i].RecipientID > results[j].RecipientID
+	})
+
+	if len(results) > pageSize+1 {
+		results = results[:pageSize+1]
+	}
+	return results, nil
+}
+
+func (m *MemoryRepository) CountYouLiked(ctx context.Context, actorID string, filter YouLikedFilter) (uint64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return uint64(len(m.outgoingLikes(actorID, filter))), nil
+}
+
+// outgoingLikes returns the actor's visible likes matching filter, unordered.
+// Callers must hold at least the read lock.
+func (m *MemoryRepository) outgoingLikes(actorID string, filter YouLikedFilter) []OutgoingLike {
+	var likes []OutgoingLike
+	for k, d := range m.decisions {
+		if k.actorID != actorID || !d.liked || m.hiddenOutgoing(actorID, k.recipientID) {
+			continue
+		}
+		match, ok := m.matches[matchKey(actorID, k.recipientID)]
+		matched := ok && match.unmatchedAt == 0
+		if (filter == YouLikedPending && matched) || (filter == YouLikedMatched && !matched) {
+			continue
+		}
+		likes = append(likes, OutgoingLike{RecipientID: k.recipientID, UpdatedAtUnix: d.updatedAt, Matched: matched})
+	}
+	return likes
+}
+
+func (m *MemoryRepository) UpsertDecision(ctx context.Context, actorID, recipientID string, liked bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.users[actorID] == nil || m.users[recipientID] == nil {
+		return ErrUserNotFound
+	}
+	recorded, err := decisionRecordedEvents(actorID, []DecisionInput{{RecipientID: recipientID, Liked: liked}}, m.now().Unix())
+	if err != nil {
+		return err
+	}
+	m.upsert(actorID, recipientID, liked)
+	m.appendOutbox(recorded)
+	return nil
+}
+
+func (m *MemoryRepository) FilterUndecided(ctx context.Context, actorID string, candidateIDs []string, passExpiry time.Duration) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cutoff := m.now().Add(-passExpiry).Unix()
+	decided := map[string]bool{}
+	for _, id := range candidateIDs {
+		d, ok := m.decisions[pairKey{actorID: actorID, recipientID: id}]
+		if ok && (d.liked || passExpiry <= 0 || d.updatedAt >= cutoff) {
+			decided[id] = true
+		}
+	}
+	return undecided(candidateIDs, decided), nil
+}
+
+func (m *MemoryRepository) GetDecisions(ctx context.Context, pairs []DecisionPair) ([]PairDecisions, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []PairDecisions
+	for _, p := range pairs {
+		var pd PairDecisions
+		if d, ok := m.decisions[pairKey{actorID: p.ActorID, recipientID: p.RecipientID}]; ok {
+			pd.Outgoing = d.record(p.ActorID, p.RecipientID)
+		}
+		d, ok := m.decisions[pairKey{actorID: p.RecipientID, recipientID: p.ActorID}]
+		if ok && !m.hidden(p.RecipientID, p.ActorID) {
+			pd.Incoming = d.record(p.RecipientID, p.ActorID)
+		}
+		results = append(results, pd)
+	}
+	return results, nil
+}
+
+func (m *MemoryRepository) CheckMutualLike(ctx context.Context, actorID, recipientID string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	d, ok := m.decisions[pairKey{actorID: recipientID, recipientID: actorID}]
+	return ok && d.liked && !m.hidden(recipientID, actorID), nil
+}
+
+func (m *MemoryRepository) RecordDecision(ctx context.Context, actorID, recipientID string, liked bool) (DecisionResult, error) {
+	results, err := m.RecordDecisions(ctx, actorID, []DecisionInput{{RecipientID: recipientID, Liked: liked}})
+	if err != nil {
+		return DecisionResult{}, err
+	}
+	return results[0], nil
+}
+
+func (m *MemoryRepository) RecordDecisions(ctx context.Context, actorID string, decisions []DecisionInput) ([]DecisionResult, error) {
+	if len(decisions) == 0 {
+		return nil, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.users[actorID] == nil {
+		return nil, ErrUserNotFound
+	}
+	for _, d := range decisions {
+		if m.users[d.RecipientID] == nil {
+			return nil, ErrUserNotFound
+		}
+	}
+
+	recorded, err := decisionRecordedEvents(actorID, decisions, m.now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]DecisionResult, len(decisions))
+	for i, d := range decisions {
+		previous, ok := m.decisions[pairKey{actorID: actorID, recipientID: d.RecipientID}]
+		wasLiked := ok && previous.liked
+		m.upsert(actorID, d.RecipientID, d.Liked)
+		results[i].NewLike = d.Liked && !wasLiked && !m.hidden(actorID, d.RecipientID)
+	}
+	m.appendOutbox(recorded)
+
+	for i, d := range decisions {
+		if !d.Liked {
+			continue
+		}
+		reciprocal, ok := m.decisions[pairKey{actorID: d.RecipientID, recipientID: actorID}]
+		if !ok || !reciprocal.liked || m.hidden(d.RecipientID, actorID) {
+			continue
+		}
+		results[i].MutualLike = true
+
+		key := matchKey(actorID, d.RecipientID)
+		if _, matched := m.matches[key]; !matched {
+			match := &memMatch{id: uuid.NewString(), matchedAt: m.now().Unix()}
+			m.matches[key] = match
+			results[i].Match = &Match{ID: match.id, UserID: d.RecipientID, MatchedAtUnix: match.matchedAt}
+
+			e, err := matchCreatedEvent(actorID, results[i].Match)
+			if err != nil {
+				return nil, err
+			}
+			m.appendOutbox([]OutboxEvent{e})
+		}
+	}
+	return results, nil
+}
+
+func (m *MemoryRepository) ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]OutboxEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	var events []OutboxEvent
+	for _, e := range m.outbox {
+		if len(events) == limit {
+			break
+		}
+		if e.availableAt.After(now) {
+			continue
+		}
+		e.availableAt = now.Add(lease)
+		events = append(events, e.OutboxEvent)
+	}
+	return events, nil
+}
+
+func (m *MemoryRepository) RetryOutboxEvent(ctx context.Context, id int64, delay time.Duration, lastErr string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, e := range m.outbox {
+		if e.ID == id {
+			e.Attempts++
+			e.lastError = lastErr
+			e.availableAt = m.now().Add(delay)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryRepository) DeleteOutboxEvents(ctx context.Context, ids []int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deleted := map[int64]bool{}
+	for _, id := range ids {
+		deleted[id] = true
+	}
+	m.outbox = slices.DeleteFunc(m.outbox, func(e *memOutboxEvent) bool { return deleted[e.ID] })
+	return nil
+}
+
+func (m *MemoryRepository) ListDecisionHistory(
+	ctx context.Context,
+	actorID, recipientID string,
+	beforeID int64,
+	pageSize int,
+) ([]DecisionEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	events := m.events[pairKey{actorID: actorID, recipientID: recipientID}]
+	var results []DecisionEvent
+	for i := len(events) - 1; i >= 0 && len(results) < pageSize+1; i-- {
+		if beforeID > 0 && events[i].ID >= beforeID {
+			continue
+		}
+		results = append(results, events[i])
+	}
+	return results, nil
+}
+
+func (m *MemoryRepository) ListRecentLikes(ctx context.Context, actorID string, sinceUnix int64) ([]RecentLike, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []RecentLike
+	for k, events := range m.events {
+		if k.actorID != actorID {
+			continue
+		}
+		var likedAt int64
+		for _, e := range events {
+			if e.Liked && e.CreatedAtUnix >= sinceUnix {
+				likedAt = e.CreatedAtUnix
+			}
+		}
+		if likedAt != 0 {
+			results = append(results, RecentLike{RecipientID: k.recipientID, LikedAtUnix: likedAt})
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].LikedAtUnix != results[j].LikedAtUnix {
+			return results[i].LikedAtUnix < results[j].LikedAtUnix
+		}
+		return results[i].RecipientID < results[j].RecipientID
+	})
+	return results, nil
+}
+
+func (m *MemoryRepository) ListMatches(
+	ctx context.Context,
+	userID string,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]Match, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []Match
+	for k, match := range m.matches {
+		if match.unmatchedAt != 0 {
+			continue
+		}
+		var other string
+		switch userID {
+		case k.actorID:
+			other = k.recipientID
+		case k.recipientID:
+			other = k.actorID
+		default:
+			continue
+		}
+		if !afterCursor(match.matchedAt, match.id, cursor) {
+			continue
+		}
+		results = append(results, Match{ID: match.id, UserID: other, MatchedAtUnix: match.matchedAt})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].MatchedAtUnix != results[j].MatchedAtUnix {
+			return results[i].MatchedAtUnix > results[j].MatchedAtUnix
+		}
+		return results[i].ID > results[j].ID
+	})
+
+	if len(results) > pageSize+1 {
+		results = results[:pageSize+1]
+	}
+	return results, nil
+}
+
+func (m *MemoryRepository) Unmatch(ctx context.Context, actorID, otherID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	match, ok := m.matches[matchKey(actorID, otherID)]
+	if !ok || match.unmatchedAt != 0 {
+		return ErrNoActiveMatch
+	}
+	match.unmatchedAt = m.now().Unix()
+	match.unmatchedBy = actorID
+	return nil
+}
+
+func (m *MemoryRepository) CreateUser(ctx context.Context, userID, username string) (User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, u := range m.users {
+		if u.username == username {
+			return User{}, ErrUsernameTaken
+		}
+	}
+	u := &memUser{username: username, createdAt: m.now().Unix()}
+	m.users[userID] = u
+	return toUser(userID, u), nil
+}
+
+func (m *MemoryRepository) GetUser(ctx context.Context, userID string) (User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	u, ok := m.users[userID]
+	if !ok {
+		return User{}, ErrUserNotFound
+	}
+	return toUser(userID, u), nil
+}
+
+// DeleteUser removes the user and everything referencing them, mirroring the
+// ON DELETE CASCADE foreign keys.
+func (m *MemoryRepository) DeleteUser(ctx context.Context, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[userID]; !ok {
+		return ErrUserNotFound
+	}
+	delete(m.users, userID)
+	for k := range m.decisions {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.decisions, k)
+		}
+	}
+	for k := range m.events {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.events, k)
+		}
+	}
+	for k := range m.matches {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.matches, k)
+		}
+	}
+	for k := range m.blocks {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.blocks, k)
+		}
+	}
+	delete(m.seen, userID)
+	return nil
+}
+
+func (m *MemoryRepository) SetUserActive(ctx context.Context, userID string, active bool) (User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.users[userID]
+	if !ok {
+		return User{}, ErrUserNotFound
+	}
+	switch {
+	case active && u.erasedAt != 0:
+		return User{}, ErrUserErased
+	case active:
+		u.deactivatedAt = 0
+	case u.deactivatedAt == 0:
+		u.deactivatedAt = m.now().Unix()
+	}
+	return toUser(userID, u), nil
+}
+
+func toUser(userID string, u *memUser) User {
+	return User{
+		ID:                userID,
+		Username:          u.username,
+		CreatedAtUnix:     u.createdAt,
+		DeactivatedAtUnix: u.deactivatedAt,
+		ErasedAtUnix:      u.erasedAt,
+	}
+}
+
+func (m *MemoryRepository) ExportUserData(ctx context.Context, userID string) (UserData, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	u, ok := m.users[userID]
+	if !ok {
+		return UserData{}, ErrUserNotFound
+	}
+
+	data := UserData{User: toUser(userID, u)}
+	for k, d := range m.decisions {
+		record := *d.record(k.actorID, k.recipientID)
+		if k.actorID == userID {
+			data.DecisionsMade = append(data.DecisionsMade, record)
+		}
+		if k.recipientID == userID {
+			data.DecisionsReceived = append(data.DecisionsReceived, record)
+		}
+	}
+	for k, events := range m.events {
+		if k.actorID == userID {
+			data.EventsMade = append(data.EventsMade, events...)
+		}
+		if k.recipientID == userID {
+			data.EventsReceived = append(data.EventsReceived, events...)
+		}
+	}
+	for k, match := range m.matches {
+		var other string
+		switch userID {
+		case k.actorID:
+			other = k.recipientID
+		case k.recipientID:
+			other = k.actorID
+		default:
+			continue
+		}
+		data.Matches = append(data.Matches, Match{
+			ID:              match.id,
+			UserID:          other,
+			MatchedAtUnix:   match.matchedAt,
+			UnmatchedAtUnix: match.unmatchedAt,
+			UnmatchedBy:     match.unmatchedBy,
+		})
+	}
+	for k, createdAt := range m.blocks {
+		if k.actorID == userID {
+			data.Blocks = append(data.Blocks, Block{BlockedID: k.recipientID, CreatedAtUnix: createdAt})
+		}
+	}
+
+	sortDecisionRecords(data.DecisionsMade)
+	sortDecisionRecords(data.DecisionsReceived)
+	for _, events := range [][]DecisionEvent{data.EventsMade, data.EventsReceived} {
+		sort.Slice(events, func(i, j int) bool { return events[i].ID > events[j].ID })
+	}
+	sort.Slice(data.Matches, func(i, j int) bool {
+		if data.Matches[i].MatchedAtUnix != data.Matches[j].MatchedAtUnix {
+			return data.Matches[i].MatchedAtUnix > data.Matches[j].MatchedAtUnix
+		}
+		return data.Matches[i].ID > data.Matches[j].ID
+	})
+	sort.Slice(data.Blocks, func(i, j int) bool {
+		if data.Blocks[i].CreatedAtUnix != data.Blocks[j].CreatedAtUnix {
+			return data.Blocks[i].CreatedAtUnix > data.Blocks[j].CreatedAtUnix
+		}
+		return data.Blocks[i].BlockedID > data.Blocks[j].BlockedID
+	})
+	return data, nil
+}
+
+func sortDecisionRecords(records []DecisionRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.UpdatedAtUnix != b.UpdatedAtUnix {
+			return a.UpdatedAtUnix > b.UpdatedAtUnix
+		}
+		if a.ActorID != b.ActorID {
+			return a.ActorID > b.ActorID
+		}
+		return a.RecipientID > b.RecipientID
+	})
+}
+
+func (m *MemoryRepository) EraseUserData(ctx context.Context, userID string) (Erasure, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.users[userID]
+	if !ok {
+		return Erasure{}, ErrUserNotFound
+	}
+
+	now := m.now().Unix()
+	erasure := Erasure{ID: uuid.NewString(), UserID: userID, RequestedAtUnix: now, AlreadyErased: u.erasedAt != 0}
+	for k := range m.decisions {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.decisions, k)
+			erasure.DecisionsDeleted++
+		}
+	}
+	for k := range m.events {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.events, k)
+		}
+	}
+	matchIDs := map[string]bool{}
+	for k, match := range m.matches {
+		if k.actorID == userID || k.recipientID == userID {
+			matchIDs[match.id] = true
+			delete(m.matches, k)
+			erasure.MatchesDeleted++
+		}
+	}
+	for k := range m.blocks {
+		if k.actorID == userID || k.recipientID == userID {
+			delete(m.blocks, k)
+			erasure.BlocksDeleted++
+		}
+	}
+
+	delete(m.seen, userID)
+	m.webhookDeliveries = slices.DeleteFunc(m.webhookDeliveries, func(d *memWebhookDelivery) bool { return matchIDs[d.MatchID] })
+	m.outbox = slices.DeleteFunc(m.outbox, func(e *memOutboxEvent) bool { return e.ActorID == userID || e.RecipientID == userID })
+
+	u.username = erasedUsernamePrefix + userID
+	if u.deactivatedAt == 0 {
+		u.deactivatedAt = now
+	}
+	if u.erasedAt == 0 {
+		u.erasedAt = now
+	}
+	m.erasures = append(m.erasures, erasure)
+	return erasure, nil
+}
+
+func (m *MemoryRepository) BlockUser(ctx context.Context, blockerID, blockedID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.users[blockerID] == nil || m.users[blockedID] == nil {
+		return ErrUserNotFound
+	}
+
+	key := pairKey{actorID: blockerID, recipientID: blockedID}
+	if _, ok := m.blocks[key]; !ok {
+		m.blocks[key] = m.now().Unix()
+	}
+	return nil
+}
+
+func (m *MemoryRepository) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.blocks, pairKey{actorID: blockerID, recipientID: blockedID})
+	return nil
+}
+
+func (m *MemoryRepository) ListBlockedUsers(
+	ctx context.Context,
+	blockerID string,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]Block, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []Block
+	for k, createdAt := range m.blocks {
+		if k.actorID != blockerID || !afterCursor(createdAt, k.recipientID, cursor) {
+			continue
+		}
+		results = append(results, Block{BlockedID: k.recipientID, CreatedAtUnix: createdAt})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].CreatedAtUnix != results[j].CreatedAtUnix {
+			return results[i].CreatedAtUnix > results[j].CreatedAtUnix
+		}
+		return results[i].BlockedID > results[j].BlockedID
+	})
+
+	if len(results) > pageSize+1 {
+		results = results[:pageSize+1]
+	}
+	return results, nil
+}
+
+// hidden is the in-memory equivalent of hiddenPairFilter, reporting whether
+// a decision made by actorID about recipientID must be hidden. Callers must
+// hold at least the read lock.
+func (m *MemoryRepository) hidden(actorID, recipientID string) bool {
+	if match, ok := m.matches[matchKey(actorID, recipientID)]; ok && match.unmatchedAt != 0 {
+		return true
+	}
+	if _, ok := m.blocks[pairKey{actorID: actorID, recipientID: recipientID}]; ok {
+		return true
+	}
+	if _, ok := m.blocks[pairKey{actorID: recipientID, recipientID: actorID}]; ok {
+		return true
+	}
+	u, ok := m.users[actorID]
+	return ok && u.deactivatedAt != 0
+}
+
+// hiddenOutgoing is the in-memory equivalent of outgoingHiddenFilter.
+// Callers must hold at least the read lock.
+func (m *MemoryRepository) hiddenOutgoing(actorID, recipientID string) bool {
+	if match, ok := m.matches[matchKey(actorID, recipientID)]; ok && match.unmatchedAt != 0 {
+		return true
+	}
+	if _, ok := m.blocks[pairKey{actorID: actorID, recipientID: recipientID}]; ok {
+		return true
+	}
+	u, ok := m.users[recipientID]
+	return ok && u.deactivatedAt != 0
+}
+
+func matchKey(a, b string) pairKey {
+	low, high := orderedPair(a, b)
+	return pairKey{actorID: low, recipientID: high}
+}
+
+func (m *MemoryRepository) EnqueueWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for _, d := range deliveries {
+		enqueued := slices.ContainsFunc(m.webhookDeliveries, func(e *memWebhookDelivery) bool {
+			return e.EventID == d.EventID && e.EndpointURL == d.EndpointURL
+		})
+		if enqueued {
+			continue
+		}
+		m.lastWebhookDeliveryID++
+		m.webhookDeliveries = append(m.webhookDeliveries, &memWebhookDelivery{
+			WebhookDelivery: WebhookDelivery{
+				ID:            m.lastWebhookDeliveryID,
+				EventID:       d.EventID,
+				MatchID:       d.MatchID,
+				EndpointURL:   d.EndpointURL,
+				Payload:       d.Payload,
+				Status:        WebhookPending,
+				CreatedAtUnix: now.Unix(),
+				UpdatedAtUnix: now.Unix(),
+			},
+			availableAt: now,
+		})
+	}
+	return nil
+}
+
+func (m *MemoryRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	var deliveries []WebhookDelivery
+	for _, d := range m.webhookDeliveries {
+		if len(deliveries) == limit {
+			break
+		}
+		if d.Status != WebhookPending || d.availableAt.After(now) {
+			continue
+		}
+		deliveries = append(deliveries, d.snapshot())
+		d.availableAt = now.Add(lease)
+	}
+	return deliveries, nil
+}
+
+func (m *MemoryRepository) RecordWebhookAttempt(ctx context.Context, id int64, attempt WebhookAttempt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for _, d := range m.webhookDeliveries {
+		if d.ID != id || d.Status != WebhookPending {
+			continue
+		}
+		d.Status = attempt.status()
+		d.Attempts++
+		d.LastStatusCode = attempt.StatusCode
+		d.LastError = attempt.Err
+		d.UpdatedAtUnix = now.Unix()
+		d.availableAt = now.Add(attempt.RetryIn)
+	}
+	return nil
+}
+
+func (m *MemoryRepository) ListWebhookDeliveries(
+	ctx context.Context,
+	filter WebhookDeliveryFilter,
+	beforeID int64,
+	pageSize int,
+) ([]WebhookDelivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var deliveries []WebhookDelivery
+	for i := len(m.webhookDeliveries) - 1; i >= 0 && len(deliveries) <= pageSize; i-- {
+		d := m.webhookDeliveries[i]
+		if (filter.MatchID != "" && d.MatchID != filter.MatchID) ||
+			(filter.Status != "" && d.Status != filter.Status) ||
+			(beforeID > 0 && d.ID >= beforeID) {
+			continue
+		}
+		deliveries = append(deliveries, d.snapshot())
+	}
+	return deliveries, nil
+}
+
+// snapshot returns a copy of the delivery as the MySQL Repository reads it.
+func (d *memWebhookDelivery) snapshot() WebhookDelivery {
+	delivery := d.WebhookDelivery
+	if d.Status == WebhookPending {
+		delivery.NextAttemptAtUnix = d.availableAt.Unix()
+	}
+	return delivery
+}
+
+// DisableOutbox mirrors Repository.DisableOutbox.
+func (m *MemoryRepository) DisableOutbox() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.outboxDisabled = true
+}
+
+// appendOutbox mirrors insertOutboxEvents, callers must hold the write lock.
+func (m *MemoryRepository) appendOutbox(events []OutboxEvent) {
+	if m.outboxDisabled {
+		return
+	}
+	now := m.now()
+	for _, e := range events {
+		m.lastOutboxID++
+		e.ID = m.lastOutboxID
+		e.CreatedAtUnix = now.Unix()
+		m.outbox = append(m.outbox, &memOutboxEvent{OutboxEvent: e, availableAt: now})
+	}
+}
+
+// upsert mirrors INSERT ... ON DUPLICATE KEY UPDATE plus the decision_events
+// insert, callers must hold the write lock.
+func (m *MemoryRepository) upsert(actorID, recipientID string, liked bool) {
+	now := m.now().Unix()
+	key := pairKey{actorID: actorID, recipientID: recipientID}
+	m.lastEventID++
+	m.events[key] = append(m.events[key], DecisionEvent{
+		ID:            m.lastEventID,
+		ActorID:       actorID,
+		RecipientID:   recipientID,
+		Liked:         liked,
+		CreatedAtUnix: now,
+	})
+	if d, ok := m.decisions[key]; ok {
+		d.liked = liked
+		d.updatedAt = now
+		return
+	}
+	m.decisions[key] = &memDecision{liked: liked, createdAt: now, updatedAt: now}
+}
+
+// listLikers returns one page (plus one extra row to detect a next page) of
+// actors who liked recipientID, ordered by updated_at DESC, actor_id DESC.
+// Callers must hold at least the read lock.
+func (m *MemoryRepository) listLikers(
+	recipientID string,
+	order LikedYouOrder,
+	window TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+	include func(actorID string) bool,
+) []Decision {
+	ascending := order == LikedYouOldestFirst
+	after := afterCursor
+	if ascending {
+		after = afterAscendingCursor
+	}
+
+	var results []Decision
+	for k, d := range m.decisions {
+		if k.recipientID != recipientID || !d.liked || m.hidden(k.actorID, recipientID) || !include(k.actorID) {
+			continue
+		}
+		if !window.Contains(d.updatedAt) {
+			continue
+		}
+		decision := Decision{ActorID: k.actorID, CreatedAtUnix: d.createdAt, UpdatedAtUnix: d.updatedAt}
+		if !after(order.SortTime(decision), k.actorID, cursor) {
+			continue
+		}
+		results = append(results, decision)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if ascending {
+			a, b = b, a
+		}
+		if order.SortTime(a) != order.SortTime(b) {
+			return order.SortTime(a) > order.SortTime(b)
+		}
+		return a.ActorID > b.ActorID
+	})
+
+	if len(results) > pageSize+1 {
+		results = results[:pageSize+1]
+	}
+	return results
+}
+
+// afterCursor is the in-memory equivalent of appendSeekPredicate.
+func afterCursor(unixTs int64, id string, cursor pagination.Cursor) bool {
+	switch {
+	case cursor.IsZero():
+		return true
+	case cursor.IsLegacy():
+		return unixTs < cursor.UnixTs
+	default:
+		return unixTs < cursor.UnixTs || (unixTs == cursor.UnixTs && id < cursor.ID)
+	}
+}
+
+// afterAscendingCursor is the in-memory equivalent of appendAscendingSeekPredicate.
+func afterAscendingCursor(unixTs int64, id string, cursor pagination.Cursor) bool {
+	switch {
+	case cursor.IsZero():
+		return true
+	case cursor.IsLegacy():
+		return unixTs > cursor.UnixTs
+	default:
+		return unixTs > cursor.UnixTs || (unixTs == cursor.UnixTs && id > cursor.ID)
+	}
+}
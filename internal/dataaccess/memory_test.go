@@ -0,0 +1,388 @@
+package dataaccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+func Test_MemoryRepository_ListLikedYou_Ordering(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+
+	clock := time.Unix(1730000000, 0)
+	repo.now = func() time.Time { return clock }
+	createMemoryUsers(t, repo, "recipient", "actor-a", "actor-b", "actor-c", "actor-d")
+
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", true)
+	_ = repo.UpsertDecision(ctx, "actor-c", "recipient", true)
+	clock = clock.Add(time.Second)
+	_ = repo.UpsertDecision(ctx, "actor-b", "recipient", true)
+	_ = repo.UpsertDecision(ctx, "actor-d", "recipient", false)
+
+	decisions, err := repo.ListLikedYou(ctx, "recipient", LikedYouRecentActivity, TimeWindow{}, pagination.Cursor{}, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := []string{"actor-b", "actor-c", "actor-a"}
+	if len(decisions) != len(want) {
+		t.Fatalf("expected %d decisions, got %d", len(want), len(decisions))
+	}
+	for i, d := range decisions {
+		if d.ActorID != want[i] {
+			t.Errorf("position %d: expected %s, got %s", i, want[i], d.ActorID)
+		}
+	}
+
+	// resume after actor-c, which shares its second with actor-a
+	cursor := pagination.Cursor{UnixTs: 1730000000, ID: "actor-c"}
+	decisions, _ = repo.ListLikedYou(ctx, "recipient", LikedYouRecentActivity, TimeWindow{}, cursor, 10)
+	if len(decisions) != 1 || decisions[0].ActorID != "actor-a" {
+		t.Errorf("expected only actor-a after cursor, got %+v", decisions)
+	}
+}
+
+func Test_MemoryRepository_ListLikedYou_FirstLikedOrders(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+
+	clock := time.Unix(1730000000, 0)
+	repo.now = func() time.Time { return clock }
+	createMemoryUsers(t, repo, "recipient", "actor-a", "actor-b", "actor-c")
+
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", true)
+	clock = clock.Add(time.Second)
+	_ = repo.UpsertDecision(ctx, "actor-b", "recipient", true)
+	clock = clock.Add(time.Second)
+	_ = repo.UpsertDecision(ctx, "actor-c", "recipient", true)
+	// actor-a flip-flops, which only moves them up in the recent activity order
+	clock = clock.Add(time.Second)
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", false)
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", true)
+
+	tests := []struct {
+		order LikedYouOrder
+		want  []string
+	}{
+		{LikedYouRecentActivity, []string{"actor-a", "actor-c", "actor-b"}},
+		{LikedYouFirstLiked, []string{"actor-c", "actor-b", "actor-a"}},
+		{LikedYouOldestFirst, []string{"actor-a", "actor-b", "actor-c"}},
+	}
+	for _, tt := range tests {
+		// one liker per page to exercise the cursor
+		var got []string
+		var cursor pagination.Cursor
+		for len(got) < 10 {
+			page, err := repo.ListLikedYou(ctx, "recipient", tt.order, TimeWindow{}, cursor, 1)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			got = append(got, page[0].ActorID)
+			if len(page) == 1 {
+				break
+			}
+			cursor = pagination.Cursor{UnixTs: tt.order.SortTime(page[0]), ID: page[0].ActorID}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("order %d: expected %v, got %v", tt.order, tt.want, got)
+		}
+	}
+}
+
+func Test_MemoryRepository_ListLikedYou_FirstLikedWindow(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+
+	clock := time.Unix(1730000000, 0)
+	repo.now = func() time.Time { return clock }
+	createMemoryUsers(t, repo, "recipient", "actor-a", "actor-b", "actor-c")
+
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", true)
+	_ = repo.UpsertDecision(ctx, "actor-b", "recipient", true)
+	clock = clock.Add(time.Hour)
+	_ = repo.UpsertDecision(ctx, "actor-c", "recipient", true)
+	// actor-a first liked before the window but liked again inside it
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", false)
+	_ = repo.UpsertDecision(ctx, "actor-a", "recipient", true)
+
+	// the window bounds the latest like in every order, like CountLikedYou
+	window := TimeWindow{SinceUnix: clock.Unix()}
+	page, err := repo.ListLikedYou(ctx, "recipient", LikedYouFirstLiked, window, pagination.Cursor{}, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var got []string
+	for _, d := range page {
+		got = append(got, d.ActorID)
+	}
+	if want := []string{"actor-c", "actor-a"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if page[1].CreatedAtUnix >= window.SinceUnix {
+		t.Errorf("expected actor-a to keep its first like time, got %d", page[1].CreatedAtUnix)
+	}
+
+	count, err := repo.CountLikedYou(ctx, "recipient", window)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != uint64(len(page)) {
+		t.Errorf("expected the count to match the list, got %d", count)
+	}
+}
+
+func Test_MemoryRepository_ConcurrentUpserts(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+	createMemoryUsers(t, repo, "recipient")
+	for i := 0; i < 50; i++ {
+		createMemoryUsers(t, repo, fmt.Sprintf("actor-%d", i))
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = repo.UpsertDecision(ctx, fmt.Sprintf("actor-%d", i), "recipient", true)
+			_, _ = repo.CountLikedYou(ctx, "recipient", TimeWindow{})
+		}(i)
+	}
+	wg.Wait()
+
+	count, err := repo.CountLikedYou(ctx, "recipient", TimeWindow{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 50 {
+		t.Errorf("expected 50 likes, got %d", count)
+	}
+}
+
+func Test_MemoryRepository_UnknownUser(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+	createMemoryUsers(t, repo, "actor")
+
+	if err := repo.UpsertDecision(ctx, "actor", "missing", true); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+	if _, err := repo.RecordDecision(ctx, "missing", "actor", true); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func Test_MemoryRepository_FilterUndecided_PassExpiry(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+
+	clock := time.Unix(1730000000, 0)
+	repo.now = func() time.Time { return clock }
+	createMemoryUsers(t, repo, "actor", "liked", "old-pass", "new-pass", "fresh")
+
+	_ = repo.UpsertDecision(ctx, "actor", "liked", true)
+	_ = repo.UpsertDecision(ctx, "actor", "old-pass", false)
+	clock = clock.Add(time.Hour)
+	_ = repo.UpsertDecision(ctx, "actor", "new-pass", false)
+
+	candidates := []string{"fresh", "old-pass", "liked", "new-pass", "fresh"}
+	for _, tc := range []struct {
+		passExpiry time.Duration
+		want       []string
+	}{
+		{0, []string{"fresh"}},
+		{time.Minute, []string{"fresh", "old-pass"}},
+	} {
+		got, err := repo.FilterUndecided(ctx, "actor", candidates, tc.passExpiry)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("pass expiry %v: expected %v, got %v", tc.passExpiry, tc.want, got)
+		}
+	}
+}
+
+func Test_MemoryRepository_ListRecentLikes(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+
+	clock := time.Unix(1730000000, 0)
+	repo.now = func() time.Time { return clock }
+	createMemoryUsers(t, repo, "actor", "old", "relike", "unliked", "passed")
+
+	_ = repo.UpsertDecision(ctx, "actor", "old", true)
+	_ = repo.UpsertDecision(ctx, "actor", "relike", true)
+	clock = clock.Add(time.Hour)
+	_ = repo.UpsertDecision(ctx, "actor", "unliked", true)
+	_ = repo.UpsertDecision(ctx, "actor", "unliked", false)
+	_ = repo.UpsertDecision(ctx, "actor", "passed", false)
+	clock = clock.Add(time.Hour)
+	_ = repo.UpsertDecision(ctx, "actor", "relike", true)
+
+	got, err := repo.ListRecentLikes(ctx, "actor", 1730000000+60)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := []RecentLike{
+		{RecipientID: "unliked", LikedAtUnix: 1730003600},
+		{RecipientID: "relike", LikedAtUnix: 1730007200},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func Test_MemoryRepository_MarkLikesSeen_Concurrent(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+	createMemoryUsers(t, repo, "recipient")
+
+	// devices race to mark different positions, the newest one must win
+	var wg sync.WaitGroup
+	for i := 1; i <= 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := pagination.Cursor{UnixTs: int64(1730000000 + i%10), ID: fmt.Sprintf("actor-%02d", i)}
+			if err := repo.MarkLikesSeen(ctx, "recipient", c); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := repo.GetLikesSeen(ctx, "recipient")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := pagination.Cursor{UnixTs: 1730000009, ID: "actor-49"}
+	if got != want {
+		t.Errorf("expected watermark %+v, got %+v", want, got)
+	}
+}
+
+func Test_MemoryRepository_Outbox(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+
+	clock := time.Unix(1730000000, 0)
+	repo.now = func() time.Time { return clock }
+	createMemoryUsers(t, repo, "a", "b")
+
+	if err := repo.UpsertDecision(ctx, "a", "b", true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := repo.RecordDecision(ctx, "b", "a", true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	events, _ := repo.ClaimOutboxEvents(ctx, 10, time.Minute)
+	var types []string
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	if fmt.Sprint(types) != "[explore.DecisionRecorded explore.DecisionRecorded explore.MatchCreated]" {
+		t.Fatalf("expected two decisions and a match, got %v", types)
+	}
+
+	// claimed events are hidden until the lease runs out
+	if again, _ := repo.ClaimOutboxEvents(ctx, 10, time.Minute); len(again) != 0 {
+		t.Errorf("expected claimed events to be hidden, got %d", len(again))
+	}
+
+	_ = repo.DeleteOutboxEvents(ctx, []int64{events[0].ID, events[2].ID})
+	_ = repo.RetryOutboxEvent(ctx, events[1].ID, 2*time.Minute, "unavailable")
+
+	clock = clock.Add(time.Minute)
+	if again, _ := repo.ClaimOutboxEvents(ctx, 10, time.Minute); len(again) != 0 {
+		t.Errorf("expected the retried event to wait for its delay, got %d", len(again))
+	}
+
+	clock = clock.Add(time.Minute)
+	again, _ := repo.ClaimOutboxEvents(ctx, 10, time.Minute)
+	if len(again) != 1 || again[0].ID != events[1].ID || again[0].Attempts != 1 {
+		t.Errorf("expected only the retried event with 1 attempt, got %+v", again)
+	}
+}
+
+func Test_MemoryRepository_DisableOutbox(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+	createMemoryUsers(t, repo, "a", "b")
+	repo.DisableOutbox()
+
+	if err := repo.UpsertDecision(ctx, "a", "b", true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result, err := repo.RecordDecision(ctx, "b", "a", true); err != nil || result.Match == nil {
+		t.Fatalf("expected a match, got %+v, %v", result, err)
+	}
+	if events, _ := repo.ClaimOutboxEvents(ctx, 10, time.Minute); len(events) != 0 {
+		t.Errorf("expected no outbox events, got %d", len(events))
+	}
+}
+
+func Test_MemoryRepository_EraseUserData_OutboxAndWebhooks(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+	createMemoryUsers(t, repo, "erased", "partner", "other-a", "other-b")
+
+	match := func(actorID, recipientID string) string {
+		t.Helper()
+		if err := repo.UpsertDecision(ctx, actorID, recipientID, true); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		result, err := repo.RecordDecision(ctx, recipientID, actorID, true)
+		if err != nil || result.Match == nil {
+			t.Fatalf("expected a match, got %+v, %v", result, err)
+		}
+		err = repo.EnqueueWebhookDeliveries(ctx, []WebhookDelivery{{
+			EventID:     "event-" + result.Match.ID,
+			MatchID:     result.Match.ID,
+			EndpointURL: "https://example.com/hook",
+			Payload:     []byte(fmt.Sprintf(`{"actor_user_id":%q,"recipient_user_id":%q}`, recipientID, actorID)),
+		}})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return result.Match.ID
+	}
+	match("erased", "partner")
+	kept := match("other-a", "other-b")
+
+	if _, err := repo.EraseUserData(ctx, "erased"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	deliveries, _ := repo.ListWebhookDeliveries(ctx, WebhookDeliveryFilter{}, 0, 10)
+	if len(deliveries) != 1 || deliveries[0].MatchID != kept {
+		t.Errorf("expected only the delivery of the other match, got %+v", deliveries)
+	}
+	events, _ := repo.ClaimOutboxEvents(ctx, 10, time.Minute)
+	if len(events) != 3 {
+		t.Errorf("expected the 3 events of the other match, got %d", len(events))
+	}
+	for _, e := range events {
+		if e.ActorID == "erased" || e.RecipientID == "erased" {
+			t.Errorf("expected no event about the erased user, got %+v", e)
+		}
+	}
+}
+
+func createMemoryUsers(t *testing.T, repo *MemoryRepository, ids ...string) {
+	t.Helper()
+	for _, id := range ids {
+		if _, err := repo.CreateUser(context.Background(), id, "username-"+id); err != nil {
+			t.Fatalf("failed to create user %s: %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// ClaimOutboxEvents returns up to limit events ready to be published, oldest
+// first, and hides them from other callers for the lease so several relays
+// can run side by side. Events that are neither deleted nor retried within
+// the lease are handed out again.
+func (r *Repository) ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]OutboxEvent, error) {
+	const selectQuery = `
+		SELECT id, event_id, event_type, event_key, payload, attempts, UNIX_TIMESTAMP(created_at)
+		FROM outbox
+		WHERE available_at <= NOW(6)
+		ORDER BY id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED;
+	`
+
+	var events []OutboxEvent
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		events = nil
+
+		rows, err := tx.QueryContext(ctx, selectQuery, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var e OutboxEvent
+			if err := rows.Scan(&e.ID, &e.EventID, &e.Type, &e.Key, &e.Payload, &e.Attempts, &e.CreatedAtUnix); err != nil {
+				return err
+			}
+			events = append(events, e)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(events))
+		for i, e := range events {
+			ids[i] = e.ID
+		}
+		query, args := inIDsQuery(`
+			UPDATE outbox SET available_at = NOW(6) + INTERVAL ? MICROSECOND
+			WHERE id IN `, ids, lease.Microseconds())
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("error claiming outbox events: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// RetryOutboxEvent records a failed publish of a claimed event and hides it
+// until the delay has passed.
+func (r *Repository) RetryOutboxEvent(ctx context.Context, id int64, delay time.Duration, lastErr string) error {
+	const query = `
+		UPDATE outbox
+		SET attempts = attempts + 1, last_error = ?, available_at = NOW(6) + INTERVAL ? MICROSECOND
+		WHERE id = ?;
+	`
+	_, err := r.db.ExecContext(ctx, query, lastErr, delay.Microseconds(), id)
+	return err
+}
+
+// DeleteOutboxEvents removes published events.
+func (r *Repository) DeleteOutboxEvents(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query, args := inIDsQuery("DELETE FROM outbox WHERE id IN ", ids)
+	_, err := r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// inIDsQuery appends the IN list of ids to query, after the leading args.
+func inIDsQuery(query string, ids []int64, leading ...interface{}) (string, []interface{}) {
+	query += "(?" + strings.Repeat(", ?", len(ids)-1) + ");"
+	args := make([]interface{}, 0, len(leading)+len(ids))
+	args = append(args, leading...)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return query, args
+}
+
+// DisableOutbox stops writing events to the outbox, for servers that run no
+// relay, which would otherwise leave every event in it for good. Call it
+// before the Repository is used.
+func (r *Repository) DisableOutbox() {
+	r.outboxDisabled = true
+}
+
+// insertOutboxEvents writes the events as part of the caller's transaction.
+func (r *Repository) insertOutboxEvents(ctx context.Context, tx *sql.Tx, events []OutboxEvent) error {
+	if len(events) == 0 || r.outboxDisabled {
+		return nil
+	}
+
+	query := `
+		INSERT INTO outbox (event_id, event_type, event_key, actor_id, recipient_id, payload)
+		VALUES (?, ?, ?, ?, ?, ?)` + strings.Repeat(", (?, ?, ?, ?, ?, ?)", len(events)-1) + ";"
+	args := make([]interface{}, 0, len(events)*6)
+	for _, e := range events {
+		args = append(args, e.EventID, e.Type, e.Key, e.ActorID, e.RecipientID, e.Payload)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("error writing outbox events: %w", err)
+	}
+	return nil
+}
+
+// decisionRecordedEvents returns a DecisionRecorded event for every decision.
+func decisionRecordedEvents(actorID string, decisions []DecisionInput, unixTs int64) ([]OutboxEvent, error) {
+	events := make([]OutboxEvent, 0, len(decisions))
+	for _, d := range decisions {
+		eventID := uuid.NewString()
+		e, err := newOutboxEvent(eventID, actorID, actorID, d.RecipientID, &pb.DecisionRecorded{
+			EventId:         eventID,
+			ActorUserId:     actorID,
+			RecipientUserId: d.RecipientID,
+			LikedRecipient:  d.Liked,
+			UnixTimestamp:   uint64(unixTs),
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// matchCreatedEvent returns the MatchCreated event of a match the actor's like created.
+func matchCreatedEvent(actorID string, match *Match) (OutboxEvent, error) {
+	eventID := uuid.NewString()
+	return newOutboxEvent(eventID, match.ID, actorID, match.UserID, &pb.MatchCreated{
+		EventId:              eventID,
+		MatchId:              match.ID,
+		ActorUserId:          actorID,
+		RecipientUserId:      match.UserID,
+		MatchedUnixTimestamp: uint64(match.MatchedAtUnix),
+	})
+}
+
+func newOutboxEvent(eventID, key, actorID, recipientID string, msg proto.Message) (OutboxEvent, error) {
+	name := string(msg.ProtoReflect().Descriptor().FullName())
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("error encoding %s: %w", name, err)
+	}
+	return OutboxEvent{
+		EventID:     eventID,
+		Type:        name,
+		Key:         key,
+		ActorID:     actorID,
+		RecipientID: recipientID,
+		Payload:     payload,
+	}, nil
+}
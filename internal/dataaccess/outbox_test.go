@@ -0,0 +1,103 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_ClaimOutboxEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id, event_id, event_type, event_key, payload, attempts, UNIX_TIMESTAMP\(created_at\)\s+FROM outbox\s+WHERE available_at <= NOW\(6\)\s+ORDER BY id\s+LIMIT \?\s+FOR UPDATE SKIP LOCKED`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "event_type", "event_key", "payload", "attempts", "created_at"}).
+			AddRow(int64(1), "event1", "explore.DecisionRecorded", "actor1", []byte{1}, 0, int64(1730000000)).
+			AddRow(int64(2), "event2", "explore.MatchCreated", "match1", []byte{2}, 3, int64(1730000001)))
+	mock.ExpectExec(`UPDATE outbox SET available_at = NOW\(6\) \+ INTERVAL \? MICROSECOND\s+WHERE id IN \(\?, \?\)`).
+		WithArgs(int64(30000000), int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	events, err := repo.ClaimOutboxEvents(context.Background(), 10, 30*time.Second)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 2 || events[0].EventID != "event1" || events[1].Type != "explore.MatchCreated" || events[1].Attempts != 3 {
+		t.Errorf("unexpected events: %+v", events)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ClaimOutboxEvents_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// nothing to claim, so nothing to update
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, event_id, event_type, event_key, payload, attempts, UNIX_TIMESTAMP\\(created_at\\)").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "event_type", "event_key", "payload", "attempts", "created_at"}))
+	mock.ExpectCommit()
+
+	events, err := repo.ClaimOutboxEvents(context.Background(), 10, time.Minute)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events, got %+v", events)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RetryAndDeleteOutboxEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`UPDATE outbox\s+SET attempts = attempts \+ 1, last_error = \?, available_at = NOW\(6\) \+ INTERVAL \? MICROSECOND\s+WHERE id = \?`).
+		WithArgs("unavailable", int64(2000000), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM outbox WHERE id IN \(\?, \?\)`).
+		WithArgs(int64(5), int64(6)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	if err := repo.RetryOutboxEvent(context.Background(), 7, 2*time.Second, "unavailable"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := repo.DeleteOutboxEvents(context.Background(), []int64{5, 6}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	// nothing was published, nothing to delete
+	if err := repo.DeleteOutboxEvents(context.Background(), nil); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -5,35 +5,324 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 )
 
+// UpsertDecision stores the actor's latest decision, see writeDecisions.
 func (r *Repository) UpsertDecision(ctx context.Context, actorID, recipientID string, liked bool) error {
-	const query = `
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := r.writeDecisions(ctx, tx, actorID, []DecisionInput{{RecipientID: recipientID, Liked: liked}})
+		return err
+	})
+}
+
+func (r *Repository) CheckMutualLike(ctx context.Context, actorID, recipientID string) (bool, error) {
+	query := `
+		SELECT liked FROM decisions
+		WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE
+	` + hiddenPairFilter("decisions.actor_id", "decisions.recipient_id")
+
+	err := r.db.QueryRowContext(ctx, query, recipientID, actorID).Scan(new(int))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil // recipient hasn’t liked actor back :(
+		}
+		return false, fmt.Errorf("error checking mutual like: %w", err)
+	}
+	return true, nil
+}
+
+// RecordDecision upserts the actor's decision and checks for a reciprocal like
+// in one transaction, see RecordDecisions.
+func (r *Repository) RecordDecision(ctx context.Context, actorID, recipientID string, liked bool) (DecisionResult, error) {
+	results, err := r.RecordDecisions(ctx, actorID, []DecisionInput{{RecipientID: recipientID, Liked: liked}})
+	if err != nil {
+		return DecisionResult{}, err
+	}
+	return results[0], nil
+}
+
+// RecordDecisions upserts a batch of the actor's decisions with a single
+// multi-row statement and checks every like for a reciprocal like in the same
+// transaction, see writeDecisions. Results are returned in input order.
+//
+// The reciprocal rows are read with a locking read, so when two users like
+// each other concurrently one transaction either waits for the other to commit
+// or is picked as the deadlock victim and retried, and exactly one of them
+// reports the mutual like and creates the match. Pairs that were unmatched or
+// where either user blocked the other never match, but the decision is still
+// recorded so a blocked actor can't detect the block. Returns ErrUserNotFound
+// if the actor or any recipient does not exist.
+func (r *Repository) RecordDecisions(ctx context.Context, actorID string, decisions []DecisionInput) ([]DecisionResult, error) {
+	if len(decisions) == 0 {
+		return nil, nil
+	}
+
+	var results []DecisionResult
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		results = make([]DecisionResult, len(decisions))
+
+		deltas, err := r.writeDecisions(ctx, tx, actorID, decisions)
+		if err != nil {
+			return err
+		}
+
+		var likedIDs []string
+		for i, d := range decisions {
+			if d.Liked {
+				likedIDs = append(likedIDs, d.RecipientID)
+				results[i].NewLike = deltas[d.RecipientID] > 0
+			}
+		}
+		if len(likedIDs) == 0 {
+			return nil
+		}
+
+		reciprocal, err := lockReciprocalLikes(ctx, tx, actorID, likedIDs)
+		if err != nil {
+			return fmt.Errorf("error checking mutual like: %w", err)
+		}
+
+		var matched []OutboxEvent
+		for i, d := range decisions {
+			if !d.Liked || !reciprocal[d.RecipientID] {
+				continue
+			}
+			results[i].MutualLike = true
+
+			match, err := createMatch(ctx, tx, actorID, d.RecipientID)
+			if err != nil {
+				return err
+			}
+			results[i].Match = match
+			if match == nil {
+				continue
+			}
+
+			e, err := matchCreatedEvent(actorID, match)
+			if err != nil {
+				return err
+			}
+			matched = append(matched, e)
+		}
+		return r.insertOutboxEvents(ctx, tx, matched)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// writeDecisions upserts the decisions, appends them to decision_events and
+// the outbox and moves the recipients' liked_you_counts counters for every like/unlike
+// transition of a visible pair. It returns the counter deltas that were
+// applied. Returns ErrUserNotFound if the actor or any recipient does not
+// exist.
+func (r *Repository) writeDecisions(ctx context.Context, tx *sql.Tx, actorID string, decisions []DecisionInput) (map[string]int64, error) {
+	previous, err := lockPreviousDecisions(ctx, tx, actorID, decisions)
+	if err != nil {
+		return nil, err
+	}
+
+	upsertQuery, upsertArgs := buildUpsertDecisionsQuery(actorID, decisions)
+	if _, err := tx.ExecContext(ctx, upsertQuery, upsertArgs...); err != nil {
+		if isMySQLError(err, errForeignKeyFailed) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("error upserting decisions: %w", err)
+	}
+	if err := insertDecisionEvents(ctx, tx, actorID, decisions); err != nil {
+		return nil, err
+	}
+	if !r.outboxDisabled {
+		decidedAt, err := decidedAtUnix(ctx, tx, actorID, decisions[0].RecipientID)
+		if err != nil {
+			return nil, err
+		}
+		recorded, err := decisionRecordedEvents(actorID, decisions, decidedAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.insertOutboxEvents(ctx, tx, recorded); err != nil {
+			return nil, err
+		}
+	}
+
+	deltas := map[string]int64{}
+	for _, d := range decisions {
+		if d.Liked == previous[d.RecipientID] {
+			continue
+		}
+		previous[d.RecipientID] = d.Liked
+		if d.Liked {
+			deltas[d.RecipientID]++
+		} else {
+			deltas[d.RecipientID]--
+		}
+	}
+	for id, delta := range deltas {
+		if delta == 0 {
+			delete(deltas, id)
+		}
+	}
+	if len(deltas) == 0 {
+		return deltas, nil
+	}
+
+	visible, err := visibleRecipients(ctx, tx, actorID, slices.Sorted(maps.Keys(deltas)))
+	if err != nil {
+		return nil, err
+	}
+	for id := range deltas {
+		if !visible[id] {
+			delete(deltas, id) // hidden likes are not counted either way
+		}
+	}
+	return deltas, applyLikedYouDeltas(ctx, tx, deltas)
+}
+
+// lockPreviousDecisions returns the actor's current liked state for each
+// recipient, locking the rows so concurrent writers see each transition once.
+func lockPreviousDecisions(ctx context.Context, tx *sql.Tx, actorID string, decisions []DecisionInput) (map[string]bool, error) {
+	query := `
+		SELECT recipient_id, liked FROM decisions
+		WHERE actor_id = ? AND recipient_id IN (?` + strings.Repeat(", ?", len(decisions)-1) + `)
+		FOR UPDATE;
+	`
+	args := make([]interface{}, 0, len(decisions)+1)
+	args = append(args, actorID)
+	for _, d := range decisions {
+		args = append(args, d.RecipientID)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error reading previous decisions: %w", err)
+	}
+	defer rows.Close()
+
+	previous := map[string]bool{}
+	for rows.Next() {
+		var id string
+		var liked bool
+		if err := rows.Scan(&id, &liked); err != nil {
+			return nil, err
+		}
+		previous[id] = liked
+	}
+	return previous, rows.Err()
+}
+
+// visibleRecipients reports which of recipientIDs can see actorID's decision
+// about them, per hiddenPairFilter.
+func visibleRecipients(ctx context.Context, tx *sql.Tx, actorID string, recipientIDs []string) (map[string]bool, error) {
+	query := `
+		SELECT recipient_id FROM decisions
+		WHERE actor_id = ? AND recipient_id IN (?` + strings.Repeat(", ?", len(recipientIDs)-1) + `)
+	` + hiddenPairFilter("decisions.actor_id", "decisions.recipient_id") + ";"
+	args := make([]interface{}, 0, len(recipientIDs)+1)
+	args = append(args, actorID)
+	for _, id := range recipientIDs {
+		args = append(args, id)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error checking visibility: %w", err)
+	}
+	defer rows.Close()
+
+	visible := map[string]bool{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		visible[id] = true
+	}
+	return visible, rows.Err()
+}
+
+func buildUpsertDecisionsQuery(actorID string, decisions []DecisionInput) (string, []interface{}) {
+	values, args := decisionValues(actorID, decisions)
+	query := `
 		INSERT INTO decisions (actor_id, recipient_id, liked)
-		VALUES (?, ?, ?)
+		VALUES ` + values + `
 		ON DUPLICATE KEY UPDATE
 			liked = VALUES(liked),
 			updated_at = CURRENT_TIMESTAMP;
 	`
+	return query, args
+}
 
-	if _, err := r.db.ExecContext(ctx, query, actorID, recipientID, liked); err != nil {
-		return fmt.Errorf("error upserting decision: %w", err)
+// decidedAtUnix reads back the updated_at of a decision the transaction just
+// upserted, which is the same for every decision of the upsert, so events are
+// stamped by the database clock like the decisions they describe.
+func decidedAtUnix(ctx context.Context, tx *sql.Tx, actorID, recipientID string) (int64, error) {
+	const query = `
+		SELECT UNIX_TIMESTAMP(updated_at) FROM decisions WHERE actor_id = ? AND recipient_id = ?;
+	`
+	var unixTs int64
+	if err := tx.QueryRowContext(ctx, query, actorID, recipientID).Scan(&unixTs); err != nil {
+		return 0, fmt.Errorf("error reading decision time: %w", err)
+	}
+	return unixTs, nil
+}
+
+// insertDecisionEvents appends the decisions to the decision history.
+func insertDecisionEvents(ctx context.Context, tx *sql.Tx, actorID string, decisions []DecisionInput) error {
+	values, args := decisionValues(actorID, decisions)
+	query := `
+		INSERT INTO decision_events (actor_id, recipient_id, liked)
+		VALUES ` + values + ";"
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("error recording decision events: %w", err)
 	}
 	return nil
 }
 
-func (r *Repository) CheckMutualLike(ctx context.Context, actorID, recipientID string) (bool, error) {
-	const query = `
-		SELECT liked FROM decisions
-		WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE;
+// decisionValues returns the VALUES rows and args inserting the decisions.
+func decisionValues(actorID string, decisions []DecisionInput) (string, []interface{}) {
+	values := "(?, ?, ?)" + strings.Repeat(", (?, ?, ?)", len(decisions)-1)
+	args := make([]interface{}, 0, len(decisions)*3)
+	for _, d := range decisions {
+		args = append(args, actorID, d.RecipientID, d.Liked)
+	}
+	return values, args
+}
+
+// lockReciprocalLikes returns which of likerIDs like actorID back, excluding
+// hidden pairs, holding locks on their decision rows until the transaction ends.
+func lockReciprocalLikes(ctx context.Context, tx *sql.Tx, actorID string, likerIDs []string) (map[string]bool, error) {
+	query := `
+		SELECT actor_id FROM decisions
+		WHERE actor_id IN (?` + strings.Repeat(", ?", len(likerIDs)-1) + `)
+		  AND recipient_id = ? AND liked = TRUE
+	` + hiddenPairFilter("decisions.actor_id", "decisions.recipient_id") + `
+		FOR UPDATE;
 	`
+	args := make([]interface{}, 0, len(likerIDs)+1)
+	for _, id := range likerIDs {
+		args = append(args, id)
+	}
+	args = append(args, actorID)
 
-	err := r.db.QueryRowContext(ctx, query, recipientID, actorID).Scan(new(int))
+	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return false, nil // recipient hasn’t liked actor back :(
+		return nil, err
+	}
+	defer rows.Close()
+
+	reciprocal := map[string]bool{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
 		}
-		return false, fmt.Errorf("error checking mutual like: %w", err)
+		reciprocal[id] = true
 	}
-	return true, nil
+	return reciprocal, rows.Err()
 }
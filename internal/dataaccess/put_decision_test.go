@@ -3,10 +3,14 @@ package dataaccess
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/protobuf/proto"
 )
 
 func Test_UpsertDecision_Success(t *testing.T) {
@@ -18,10 +22,31 @@ func Test_UpsertDecision_Success(t *testing.T) {
 
 	repo := NewRepository(db)
 
-	// Expect the upsert query to be executed successfully
+	// Expect the upsert and the history insert to be executed in one transaction
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
 	mock.ExpectExec("INSERT INTO decisions").
 		WithArgs("actor1", "recipient1", true).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO decision_events").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	// the event carries the time the database gave the decision
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "recipient1", decisionRecordedAt(1730000000)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("recipient1"))
+	mock.ExpectExec("INSERT INTO liked_you_counts").
+		WithArgs("recipient1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	ctx := context.Background()
 	err = repo.UpsertDecision(ctx, "actor1", "recipient1", true)
@@ -35,6 +60,15 @@ func Test_UpsertDecision_Success(t *testing.T) {
 	}
 }
 
+// decisionRecordedAt matches a DecisionRecorded payload with the timestamp.
+type decisionRecordedAt uint64
+
+func (ts decisionRecordedAt) Match(v driver.Value) bool {
+	payload, ok := v.([]byte)
+	var event pb.DecisionRecorded
+	return ok && proto.Unmarshal(payload, &event) == nil && event.UnixTimestamp == uint64(ts)
+}
+
 func Test_UpsertDecision_Failure(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -44,9 +78,14 @@ func Test_UpsertDecision_Failure(t *testing.T) {
 
 	repo := NewRepository(db)
 
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
 	mock.ExpectExec("INSERT INTO decisions").
 		WithArgs("actor1", "recipient1", false).
 		WillReturnError(errors.New("insert failed"))
+	mock.ExpectRollback()
 
 	ctx := context.Background()
 	err = repo.UpsertDecision(ctx, "actor1", "recipient1", false)
@@ -144,3 +183,318 @@ func Test_CheckMutualLike_QueryError(t *testing.T) {
 		t.Errorf("unfulfilled expectations: %v", err)
 	}
 }
+
+func Test_RecordDecision_MutualLike(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
+	mock.ExpectExec("INSERT INTO decisions").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO decision_events").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "recipient1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("recipient1"))
+	mock.ExpectExec("INSERT INTO liked_you_counts").
+		WithArgs("recipient1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT actor_id FROM decisions .* FOR UPDATE").
+		WithArgs("recipient1", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id"}).AddRow("recipient1"))
+	mock.ExpectExec("INSERT INTO matches").
+		WithArgs(sqlmock.AnyArg(), "actor1", "recipient1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(matched_at\\) FROM matches").
+		WillReturnRows(sqlmock.NewRows([]string{"matched_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.MatchCreated", sqlmock.AnyArg(), "actor1", "recipient1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	result, err := repo.RecordDecision(context.Background(), "actor1", "recipient1", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.MutualLike {
+		t.Errorf("expected mutual like to be true, got false")
+	}
+	if result.Match == nil || result.Match.UserID != "recipient1" || result.Match.MatchedAtUnix != 1730000000 {
+		t.Errorf("expected a new match with recipient1, got %+v", result.Match)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RecordDecision_AlreadyMatched(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("recipient1", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
+	mock.ExpectExec("INSERT INTO decisions").
+		WithArgs("recipient1", "actor1", true).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectExec("INSERT INTO decision_events").
+		WithArgs("recipient1", "actor1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("recipient1", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.DecisionRecorded", "recipient1", "recipient1", "actor1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions").
+		WithArgs("recipient1", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("actor1"))
+	mock.ExpectExec("INSERT INTO liked_you_counts").
+		WithArgs("actor1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT actor_id FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id"}).AddRow("actor1"))
+	// the pair already has a match so the insert is a no-op
+	mock.ExpectExec("INSERT INTO matches").
+		WithArgs(sqlmock.AnyArg(), "actor1", "recipient1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	result, err := repo.RecordDecision(context.Background(), "recipient1", "actor1", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.MutualLike {
+		t.Errorf("expected mutual like to be true, got false")
+	}
+	if result.Match != nil {
+		t.Errorf("expected no new match, got %+v", result.Match)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RecordDecision_RetriesDeadlock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// first attempt is picked as the deadlock victim
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
+	mock.ExpectExec("INSERT INTO decisions").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO decision_events").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "recipient1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("recipient1"))
+	mock.ExpectExec("INSERT INTO liked_you_counts").
+		WithArgs("recipient1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT actor_id FROM decisions .* FOR UPDATE").
+		WithArgs("recipient1", "actor1").
+		WillReturnError(&mysql.MySQLError{Number: errLockDeadlock, Message: "Deadlock found when trying to get lock"})
+	mock.ExpectRollback()
+
+	// the retry sees the other side's committed like
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
+	mock.ExpectExec("INSERT INTO decisions").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO decision_events").
+		WithArgs("actor1", "recipient1", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "recipient1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("recipient1"))
+	mock.ExpectExec("INSERT INTO liked_you_counts").
+		WithArgs("recipient1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT actor_id FROM decisions .* FOR UPDATE").
+		WithArgs("recipient1", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id"}).AddRow("recipient1"))
+	mock.ExpectExec("INSERT INTO matches").
+		WithArgs(sqlmock.AnyArg(), "actor1", "recipient1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(matched_at\\) FROM matches").
+		WillReturnRows(sqlmock.NewRows([]string{"matched_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.MatchCreated", sqlmock.AnyArg(), "actor1", "recipient1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	result, err := repo.RecordDecision(context.Background(), "actor1", "recipient1", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.MutualLike {
+		t.Errorf("expected mutual like to be true, got false")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RecordDecision_Pass(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
+	mock.ExpectExec("INSERT INTO decisions").
+		WithArgs("actor1", "recipient1", false).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO decision_events").
+		WithArgs("actor1", "recipient1", false).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("actor1", "recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "recipient1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	result, err := repo.RecordDecision(context.Background(), "actor1", "recipient1", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.MutualLike {
+		t.Errorf("expected mutual like to be false, got true")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RecordDecisions_Batch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	// r3 was already liked, only r1 moves a counter
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "r1", "r2", "r3").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}).
+			AddRow("r3", true))
+	mock.ExpectExec(`INSERT INTO decisions \(actor_id, recipient_id, liked\) VALUES \(\?, \?, \?\), \(\?, \?, \?\), \(\?, \?, \?\)`).
+		WithArgs("actor1", "r1", true, "actor1", "r2", false, "actor1", "r3", true).
+		WillReturnResult(sqlmock.NewResult(3, 3))
+	mock.ExpectExec(`INSERT INTO decision_events \(actor_id, recipient_id, liked\) VALUES \(\?, \?, \?\), \(\?, \?, \?\), \(\?, \?, \?\)`).
+		WithArgs("actor1", "r1", true, "actor1", "r2", false, "actor1", "r3", true).
+		WillReturnResult(sqlmock.NewResult(3, 3))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(updated_at\\) FROM decisions").
+		WithArgs("actor1", "r1").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec(`INSERT INTO outbox \(event_id, event_type, event_key, actor_id, recipient_id, payload\) VALUES \(\?, \?, \?, \?, \?, \?\), \(\?, \?, \?, \?, \?, \?\), \(\?, \?, \?, \?, \?, \?\)`).
+		WithArgs(
+			sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "r1", sqlmock.AnyArg(),
+			sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "r2", sqlmock.AnyArg(),
+			sqlmock.AnyArg(), "explore.DecisionRecorded", "actor1", "actor1", "r3", sqlmock.AnyArg(),
+		).
+		WillReturnResult(sqlmock.NewResult(3, 3))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions").
+		WithArgs("actor1", "r1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("r1"))
+	mock.ExpectExec("INSERT INTO liked_you_counts").
+		WithArgs("r1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT actor_id FROM decisions WHERE actor_id IN \(\?, \?\) .* FOR UPDATE`).
+		WithArgs("r1", "r3", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"actor_id"}).AddRow("r3"))
+	mock.ExpectExec("INSERT INTO matches").
+		WithArgs(sqlmock.AnyArg(), "actor1", "r3").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT UNIX_TIMESTAMP\(matched_at\) FROM matches`).
+		WillReturnRows(sqlmock.NewRows([]string{"matched_at"}).AddRow(int64(1730000000)))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), "explore.MatchCreated", sqlmock.AnyArg(), "actor1", "r3", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	results, err := repo.RecordDecisions(context.Background(), "actor1", []DecisionInput{
+		{RecipientID: "r1", Liked: true},
+		{RecipientID: "r2", Liked: false},
+		{RecipientID: "r3", Liked: true},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].MutualLike || results[1].MutualLike {
+		t.Errorf("expected only r3 to be mutual, got %+v", results)
+	}
+	if !results[2].MutualLike || results[2].Match == nil || results[2].Match.UserID != "r3" {
+		t.Errorf("expected r3 to match, got %+v", results[2])
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListRecentLikes returns every distinct recipient actorID liked at or after
+// sinceUnix, with the time of the latest like, oldest first. Passes and
+// unlikes don't take back a like, it counts until it leaves the window. It
+// reads from the primary, the quota is checked right after the last swipe.
+func (r *Repository) ListRecentLikes(ctx context.Context, actorID string, sinceUnix int64) ([]RecentLike, error) {
+	const query = `
+		SELECT recipient_id, UNIX_TIMESTAMP(MAX(created_at)) AS liked_at
+		FROM decision_events
+		WHERE actor_id = ? AND liked = TRUE AND created_at >= FROM_UNIXTIME(?)
+		GROUP BY recipient_id
+		ORDER BY liked_at, recipient_id;
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, actorID, sinceUnix)
+	if err != nil {
+		return nil, fmt.Errorf("error listing recent likes: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RecentLike
+	for rows.Next() {
+		var l RecentLike
+		if err := rows.Scan(&l.RecipientID, &l.LikedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
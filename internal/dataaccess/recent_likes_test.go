@@ -0,0 +1,36 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_ListRecentLikes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery("SELECT recipient_id, UNIX_TIMESTAMP\\(MAX\\(created_at\\)\\) AS liked_at\\s+FROM decision_events\\s+WHERE actor_id = \\? AND liked = TRUE AND created_at >= FROM_UNIXTIME\\(\\?\\)\\s+GROUP BY recipient_id\\s+ORDER BY liked_at, recipient_id").
+		WithArgs("actor1", int64(1730000000)).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked_at"}).
+			AddRow("recipient1", int64(1730000100)).
+			AddRow("recipient2", int64(1730000200)))
+
+	likes, err := repo.ListRecentLikes(context.Background(), "actor1", 1730000000)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(likes) != 2 || likes[0].RecipientID != "recipient1" || likes[1].LikedAtUnix != 1730000200 {
+		t.Errorf("unexpected likes: %+v", likes)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	replicaCheckInterval = 5 * time.Second
+	replicaPingTimeout   = time.Second
+)
+
+type replica struct {
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// replicaPool hands out read replicas round-robin, skipping the ones that
+// failed their last health check. Replicas are pinged once when the pool is
+// created and then every replicaCheckInterval until the pool is closed.
+type replicaPool struct {
+	replicas []*replica
+	next     atomic.Uint64
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newReplicaPool(dbs []*sql.DB) *replicaPool {
+	p := &replicaPool{stop: make(chan struct{})}
+	for _, db := range dbs {
+		r := &replica{db: db}
+		r.healthy.Store(true)
+		p.replicas = append(p.replicas, r)
+	}
+	p.checkHealth(context.Background())
+	go p.run()
+	return p
+}
+
+// pick returns the next healthy replica, or nil when every replica is down.
+func (p *replicaPool) pick() *sql.DB {
+	n := uint64(len(p.replicas))
+	start := p.next.Add(1) - 1
+	for i := uint64(0); i < n; i++ {
+		r := p.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+	return nil
+}
+
+func (p *replicaPool) run() {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.checkHealth(context.Background())
+		}
+	}
+}
+
+// checkHealth pings every replica and records whether it answered.
+func (p *replicaPool) checkHealth(ctx context.Context) {
+	for i, r := range p.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, replicaPingTimeout)
+		err := r.db.PingContext(pingCtx)
+		cancel()
+
+		healthy := err == nil
+		if r.healthy.Swap(healthy) != healthy {
+			if healthy {
+				log.Printf("read replica %d is back up", i)
+			} else {
+				log.Printf("read replica %d is down, routing its reads elsewhere: %v", i, err)
+			}
+		}
+	}
+}
+
+func (p *replicaPool) close() {
+	p.stopOnce.Do(func() { close(p.stop) })
+	for _, r := range p.replicas {
+		r.db.Close()
+	}
+}
+
+type readPrimaryKey struct{}
+
+// ReadPrimary returns a context whose reads all go to the primary, for
+// callers that keep what they read around, such as a cache filled right
+// after it was invalidated by a write.
+func ReadPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readPrimaryKey{}, true)
+}
+
+// reader returns the connection pool for reads that tolerate replication lag,
+// a healthy replica when there is one and the primary otherwise. Reads that
+// must observe the caller's own writes, such as CheckMutualLike, and
+// everything inside a transaction use r.db directly, as do all reads with a
+// ReadPrimary context.
+func (r *Repository) reader(ctx context.Context) *sql.DB {
+	if r.replicas == nil || ctx.Value(readPrimaryKey{}) != nil {
+		return r.db
+	}
+	if db := r.replicas.pick(); db != nil {
+		return db
+	}
+	return r.db
+}
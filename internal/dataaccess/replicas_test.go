@@ -0,0 +1,97 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func newMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	return db, mock
+}
+
+func expectCount(mock sqlmock.Sqlmock, count int64) {
+	mock.ExpectQuery("SELECT GREATEST\\(liked_count, 0\\) FROM liked_you_counts").
+		WithArgs("recipient1").
+		WillReturnRows(sqlmock.NewRows([]string{"liked_count"}).AddRow(count))
+}
+
+func Test_Repository_ReplicaRouting(t *testing.T) {
+	primary, primaryMock := newMockDB(t)
+	replicaA, mockA := newMockDB(t)
+	replicaB, mockB := newMockDB(t)
+
+	// initial health check
+	mockA.ExpectPing()
+	mockB.ExpectPing()
+	repo := NewRepository(primary, replicaA, replicaB)
+	defer repo.Close()
+	ctx := context.Background()
+
+	// reads alternate between the replicas
+	expectCount(mockA, 1)
+	expectCount(mockB, 2)
+	expectCount(mockA, 1)
+	for _, want := range []uint64{1, 2, 1} {
+		got, err := repo.CountLikedYou(ctx, "recipient1", TimeWindow{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if got != want {
+			t.Errorf("expected count %d, got %d", want, got)
+		}
+	}
+
+	// mutual like checks must see the caller's own write so they stay on the primary
+	primaryMock.ExpectQuery("SELECT liked FROM decisions").
+		WithArgs("recipient1", "actor1").
+		WillReturnRows(sqlmock.NewRows([]string{"liked"}).AddRow(1))
+	if _, err := repo.CheckMutualLike(ctx, "actor1", "recipient1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// so do lag tolerant reads made with a ReadPrimary context
+	expectCount(primaryMock, 4)
+	if got, err := repo.CountLikedYou(ReadPrimary(ctx), "recipient1", TimeWindow{}); err != nil || got != 4 {
+		t.Fatalf("expected the primary's count 4, got %d, %v", got, err)
+	}
+
+	// replica A goes down, every read goes to B
+	mockA.ExpectPing().WillReturnError(errors.New("connection refused"))
+	mockB.ExpectPing()
+	repo.replicas.checkHealth(ctx)
+	expectCount(mockB, 2)
+	expectCount(mockB, 2)
+	for i := 0; i < 2; i++ {
+		if _, err := repo.CountLikedYou(ctx, "recipient1", TimeWindow{}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	// with both replicas down reads fall back to the primary
+	mockA.ExpectPing().WillReturnError(errors.New("connection refused"))
+	mockB.ExpectPing().WillReturnError(errors.New("connection refused"))
+	repo.replicas.checkHealth(ctx)
+	expectCount(primaryMock, 3)
+	got, err := repo.CountLikedYou(ctx, "recipient1", TimeWindow{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != 3 {
+		t.Errorf("expected the primary's count 3, got %d", got)
+	}
+
+	for name, mock := range map[string]sqlmock.Sqlmock{"primary": primaryMock, "replica a": mockA, "replica b": mockB} {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unfulfilled %s expectations: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package dataaccess
+
+import (
+	"context"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+// Store is the data access contract used by the service layer. Repository is
+// the MySQL backed implementation and MemoryRepository the in-memory one.
+type Store interface {
+	ListLikedYou(ctx context.Context, recipientID string, order LikedYouOrder, window TimeWindow, cursor pagination.Cursor, pageSize int) ([]Decision, error)
+	ListNewLikedYou(ctx context.Context, recipientID string, order LikedYouOrder, window TimeWindow, cursor pagination.Cursor, pageSize int) ([]Decision, error)
+	CountLikedYou(ctx context.Context, recipientID string, window TimeWindow) (uint64, error)
+	MarkLikesSeen(ctx context.Context, recipientID string, upTo pagination.Cursor) error
+	GetLikesSeen(ctx context.Context, recipientID string) (pagination.Cursor, error)
+	CountUnseenLikedYou(ctx context.Context, recipientID string) (uint64, error)
+	ListYouLiked(ctx context.Context, actorID string, filter YouLikedFilter, cursor pagination.Cursor, pageSize int) ([]OutgoingLike, error)
+	CountYouLiked(ctx context.Context, actorID string, filter YouLikedFilter) (uint64, error)
+	UpsertDecision(ctx context.Context, actorID, recipientID string, liked bool) error
+	FilterUndecided(ctx context.Context, actorID string, candidateIDs []string, passExpiry time.Duration) ([]string, error)
+	GetDecisions(ctx context.Context, pairs []DecisionPair) ([]PairDecisions, error)
+	CheckMutualLike(ctx context.Context, actorID, recipientID string) (bool, error)
+	RecordDecision(ctx context.Context, actorID, recipientID string, liked bool) (DecisionResult, error)
+	RecordDecisions(ctx context.Context, actorID string, decisions []DecisionInput) ([]DecisionResult, error)
+	ListDecisionHistory(ctx context.Context, actorID, recipientID string, beforeID int64, pageSize int) ([]DecisionEvent, error)
+	ListRecentLikes(ctx context.Context, actorID string, sinceUnix int64) ([]RecentLike, error)
+	ListMatches(ctx context.Context, userID string, cursor pagination.Cursor, pageSize int) ([]Match, error)
+	Unmatch(ctx context.Context, actorID, otherID string) error
+	CreateUser(ctx context.Context, userID, username string) (User, error)
+	GetUser(ctx context.Context, userID string) (User, error)
+	DeleteUser(ctx context.Context, userID string) error
+	SetUserActive(ctx context.Context, userID string, active bool) (User, error)
+	ExportUserData(ctx context.Context, userID string) (UserData, error)
+	EraseUserData(ctx context.Context, userID string) (Erasure, error)
+	BlockUser(ctx context.Context, blockerID, blockedID string) error
+	UnblockUser(ctx context.Context, blockerID, blockedID string) error
+	ListBlockedUsers(ctx context.Context, blockerID string, cursor pagination.Cursor, pageSize int) ([]Block, error)
+	ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]OutboxEvent, error)
+	RetryOutboxEvent(ctx context.Context, id int64, delay time.Duration, lastErr string) error
+	DeleteOutboxEvents(ctx context.Context, ids []int64) error
+	EnqueueWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, id int64, attempt WebhookAttempt) error
+	ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter, beforeID int64, pageSize int) ([]WebhookDelivery, error)
+}
+
+var (
+	_ Store = (*Repository)(nil)
+	_ Store = (*MemoryRepository)(nil)
+)
@@ -1,6 +1,255 @@
 package dataaccess
 
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNoActiveMatch is returned when a pair of users has no match to act on.
+	ErrNoActiveMatch = errors.New("users are not matched")
+	// ErrUserNotFound is returned when a referenced user does not exist.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUsernameTaken is returned when creating a user with a username already in use.
+	ErrUsernameTaken = errors.New("username already taken")
+	// ErrUserErased is returned when reactivating a user whose data was erased.
+	ErrUserErased = errors.New("user data was erased")
+)
+
 type Decision struct {
+	ActorID string
+	// CreatedAtUnix is when the actor first decided on the recipient.
+	CreatedAtUnix int64
+	UpdatedAtUnix int64
+}
+
+// LikedYouOrder selects how ListLikedYou and ListNewLikedYou order likers.
+type LikedYouOrder int
+
+const (
+	// LikedYouFirstLiked orders by the actor's first decision on the
+	// recipient, newest first, so passing and liking again doesn't move the
+	// actor up.
+	LikedYouFirstLiked LikedYouOrder = iota
+	// LikedYouRecentActivity orders by the actor's latest decision, newest first.
+	LikedYouRecentActivity
+	// LikedYouOldestFirst orders by the actor's first decision, oldest first.
+	LikedYouOldestFirst
+)
+
+// TimeWindow bounds the time of a like, SinceUnix inclusive and UntilUnix
+// exclusive. A zero bound leaves that side open. It always applies to the
+// latest like (updated_at) whatever the order, so lists agree with the
+// windowed CountLikedYou: a first-liked page can hold a liker who first liked
+// before SinceUnix and liked again inside the window. Only the recent activity
+// order can read the window as an index range, the others filter it while
+// walking the created_at index.
+type TimeWindow struct {
+	SinceUnix int64
+	UntilUnix int64
+}
+
+func (w TimeWindow) IsZero() bool {
+	return w.SinceUnix == 0 && w.UntilUnix == 0
+}
+
+// Contains reports whether unixTs falls inside the window.
+func (w TimeWindow) Contains(unixTs int64) bool {
+	return (w.SinceUnix == 0 || unixTs >= w.SinceUnix) && (w.UntilUnix == 0 || unixTs < w.UntilUnix)
+}
+
+// SortTime returns the timestamp of d that order sorts on.
+func (o LikedYouOrder) SortTime(d Decision) int64 {
+	if o == LikedYouRecentActivity {
+		return d.UpdatedAtUnix
+	}
+	return d.CreatedAtUnix
+}
+
+// OutgoingLike is a like the actor made, as listed by ListYouLiked.
+type OutgoingLike struct {
+	RecipientID   string
+	UpdatedAtUnix int64
+	// Matched is set while the pair has an active match.
+	Matched bool
+}
+
+// YouLikedFilter selects which outgoing likes ListYouLiked and CountYouLiked return.
+type YouLikedFilter int
+
+const (
+	YouLikedAll YouLikedFilter = iota
+	// YouLikedPending keeps the likes that have not (yet) turned into a match.
+	YouLikedPending
+	// YouLikedMatched keeps the likes with an active match.
+	YouLikedMatched
+)
+
+type DecisionInput struct {
+	RecipientID string
+	Liked       bool
+}
+
+type DecisionResult struct {
+	// NewLike is set when the decision turned into a like the recipient can
+	// see, liking again or liking from a hidden pair doesn't set it.
+	NewLike    bool
+	MutualLike bool
+	// Match is set when the decision created a new match.
+	Match *Match
+}
+
+type Match struct {
+	ID string
+	// UserID is the other user in the match.
+	UserID        string
+	MatchedAtUnix int64
+	// UnmatchedAtUnix and UnmatchedBy are only set for ended matches.
+	UnmatchedAtUnix int64
+	UnmatchedBy     string
+}
+
+// DecisionEvent is one entry of the append-only decision history.
+type DecisionEvent struct {
+	ID            int64
+	ActorID       string
+	RecipientID   string
+	Liked         bool
+	CreatedAtUnix int64
+}
+
+// RecentLike is a recipient the actor liked within a like quota window.
+type RecentLike struct {
+	RecipientID string
+	// LikedAtUnix is the latest like of the recipient within the window.
+	LikedAtUnix int64
+}
+
+// DecisionRecord is a full row of the decisions table.
+type DecisionRecord struct {
 	ActorID       string
+	RecipientID   string
+	Liked         bool
+	CreatedAtUnix int64
 	UpdatedAtUnix int64
 }
+
+// DecisionPair is a directed pair of users looked up by GetDecisions.
+type DecisionPair struct {
+	ActorID     string
+	RecipientID string
+}
+
+// PairDecisions holds the current decision in both directions of a pair.
+type PairDecisions struct {
+	// Outgoing is the actor's decision on the recipient, nil if they have not decided.
+	Outgoing *DecisionRecord
+	// Incoming is the recipient's decision on the actor, nil if they have not
+	// decided or the pair is hidden from the actor.
+	Incoming *DecisionRecord
+}
+
+type UserData struct {
+	User              User
+	DecisionsMade     []DecisionRecord
+	DecisionsReceived []DecisionRecord
+	// EventsMade and EventsReceived are the full like and pass history behind
+	// the current decisions, newest first.
+	EventsMade     []DecisionEvent
+	EventsReceived []DecisionEvent
+	Matches        []Match
+	Blocks         []Block
+}
+
+// Erasure is the audit record of an EraseUserData request.
+type Erasure struct {
+	ID               string
+	UserID           string
+	RequestedAtUnix  int64
+	DecisionsDeleted int64
+	MatchesDeleted   int64
+	BlocksDeleted    int64
+	AlreadyErased    bool
+}
+
+type Block struct {
+	BlockedID     string
+	CreatedAtUnix int64
+}
+
+type User struct {
+	ID            string
+	Username      string
+	CreatedAtUnix int64
+	// DeactivatedAtUnix is zero while the user is active.
+	DeactivatedAtUnix int64
+	// ErasedAtUnix is zero unless the user's data was erased.
+	ErasedAtUnix int64
+}
+
+// OutboxEvent is an event written to the outbox in the transaction of the
+// change it describes, waiting to be published.
+type OutboxEvent struct {
+	ID      int64
+	EventID string
+	// Type is the full protobuf name of the Payload message.
+	Type string
+	// Key groups related events, e.g. for partitioning, it is the actor id of
+	// decisions and the match id of matches.
+	Key string
+	// ActorID and RecipientID are the users the event is about, EraseUserData
+	// deletes the unpublished events of a user by them.
+	ActorID       string
+	RecipientID   string
+	Payload       []byte
+	Attempts      int
+	CreatedAtUnix int64
+}
+
+type WebhookStatus string
+
+const (
+	WebhookPending   WebhookStatus = "pending"
+	WebhookDelivered WebhookStatus = "delivered"
+	// WebhookDead deliveries ran out of attempts and are no longer retried.
+	WebhookDead WebhookStatus = "dead"
+)
+
+// WebhookDelivery is the delivery of one match event to one webhook endpoint.
+type WebhookDelivery struct {
+	ID          int64
+	EventID     string
+	MatchID     string
+	EndpointURL string
+	// Payload is the request body, fixed when the delivery is enqueued so
+	// every attempt sends and signs the same bytes.
+	Payload  []byte
+	Status   WebhookStatus
+	Attempts int
+	// LastStatusCode is the HTTP status of the last attempt, zero if it got no response.
+	LastStatusCode int
+	LastError      string
+	CreatedAtUnix  int64
+	UpdatedAtUnix  int64
+	// NextAttemptAtUnix is when a pending delivery is attempted next.
+	NextAttemptAtUnix int64
+}
+
+// WebhookDeliveryFilter selects the deliveries ListWebhookDeliveries returns,
+// empty fields match every delivery.
+type WebhookDeliveryFilter struct {
+	MatchID string
+	Status  WebhookStatus
+}
+
+// WebhookAttempt is the outcome of delivering a claimed delivery.
+type WebhookAttempt struct {
+	// StatusCode is the HTTP status of the response, zero without a response.
+	StatusCode int
+	// Err is empty when the endpoint accepted the delivery.
+	Err string
+	// RetryIn is the delay before the next attempt, ignored for accepted and dead deliveries.
+	RetryIn time.Duration
+	// Dead gives up on a failed delivery.
+	Dead bool
+}
@@ -0,0 +1,34 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Unmatch ends the active match between actorID and otherID, recording who
+// ended it and when, and recomputes both users' counters since the pair is
+// now hidden. Returns ErrNoActiveMatch if the pair is not matched.
+func (r *Repository) Unmatch(ctx context.Context, actorID, otherID string) error {
+	const query = `
+		UPDATE matches
+		SET unmatched_at = CURRENT_TIMESTAMP, unmatched_by = ?
+		WHERE user_low_id = ? AND user_high_id = ? AND unmatched_at IS NULL;
+	`
+
+	low, high := orderedPair(actorID, otherID)
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, query, actorID, low, high)
+		if err != nil {
+			return fmt.Errorf("error ending match: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error ending match: %w", err)
+		}
+		if n == 0 {
+			return ErrNoActiveMatch
+		}
+		return refreshLikedYouCounts(ctx, tx, []string{low, high})
+	})
+}
@@ -0,0 +1,56 @@
+package dataaccess
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_Unmatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      error
+	}{
+		{name: "ends active match", rowsAffected: 1},
+		{name: "no active match", rowsAffected: 0, wantErr: ErrNoActiveMatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to open sqlmock database: %v", err)
+			}
+			defer db.Close()
+
+			repo := NewRepository(db)
+
+			// the pair is looked up in canonical order regardless of who unmatches
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE matches SET unmatched_at = CURRENT_TIMESTAMP").
+				WithArgs("user-b", "user-a", "user-b").
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			if tt.wantErr == nil {
+				// both users' counters are recomputed now that the pair is hidden
+				mock.ExpectExec("INSERT INTO liked_you_counts .* SELECT u.id").
+					WithArgs("user-a", "user-b").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			err = repo.Unmatch(context.Background(), "user-b", "user-a")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
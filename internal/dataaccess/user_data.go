@@ -0,0 +1,271 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// erasedUsernamePrefix replaces the username of erased users, the id keeps
+// the anonymized name unique.
+const erasedUsernamePrefix = "erased-"
+
+// ExportUserData returns everything stored about the user. The reads share a
+// read-only transaction so the export is a consistent snapshot.
+func (r *Repository) ExportUserData(ctx context.Context, userID string) (UserData, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return UserData{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var data UserData
+	if data.User, err = getUser(ctx, tx, userID, false); err != nil {
+		return UserData{}, err
+	}
+	if data.DecisionsMade, err = exportDecisions(ctx, tx, "actor_id", userID); err != nil {
+		return UserData{}, err
+	}
+	if data.DecisionsReceived, err = exportDecisions(ctx, tx, "recipient_id", userID); err != nil {
+		return UserData{}, err
+	}
+	if data.EventsMade, err = exportDecisionEvents(ctx, tx, "actor_id", userID); err != nil {
+		return UserData{}, err
+	}
+	if data.EventsReceived, err = exportDecisionEvents(ctx, tx, "recipient_id", userID); err != nil {
+		return UserData{}, err
+	}
+	if data.Matches, err = exportMatches(ctx, tx, userID); err != nil {
+		return UserData{}, err
+	}
+	if data.Blocks, err = exportBlocks(ctx, tx, userID); err != nil {
+		return UserData{}, err
+	}
+	return data, nil
+}
+
+// exportDecisions returns the decisions whose column matches userID, column
+// is either actor_id or recipient_id.
+func exportDecisions(ctx context.Context, tx *sql.Tx, column, userID string) ([]DecisionRecord, error) {
+	query := `
+		SELECT actor_id, recipient_id, liked, UNIX_TIMESTAMP(created_at), UNIX_TIMESTAMP(updated_at)
+		FROM decisions
+		WHERE ` + column + ` = ?
+		ORDER BY updated_at DESC, actor_id DESC, recipient_id DESC;
+	`
+
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting decisions: %w", err)
+	}
+	defer rows.Close()
+
+	var results []DecisionRecord
+	for rows.Next() {
+		var d DecisionRecord
+		if err := rows.Scan(&d.ActorID, &d.RecipientID, &d.Liked, &d.CreatedAtUnix, &d.UpdatedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, d)
+	}
+	return results, rows.Err()
+}
+
+// exportDecisionEvents returns the decision history whose column matches
+// userID, column is either actor_id or recipient_id.
+func exportDecisionEvents(ctx context.Context, tx *sql.Tx, column, userID string) ([]DecisionEvent, error) {
+	query := `
+		SELECT id, actor_id, recipient_id, liked, UNIX_TIMESTAMP(created_at)
+		FROM decision_events
+		WHERE ` + column + ` = ?
+		ORDER BY id DESC;
+	`
+
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting decision events: %w", err)
+	}
+	defer rows.Close()
+
+	var results []DecisionEvent
+	for rows.Next() {
+		var e DecisionEvent
+		if err := rows.Scan(&e.ID, &e.ActorID, &e.RecipientID, &e.Liked, &e.CreatedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, e)
+	}
+	return results, rows.Err()
+}
+
+// exportMatches returns every match of the user, including ended ones.
+func exportMatches(ctx context.Context, tx *sql.Tx, userID string) ([]Match, error) {
+	const query = `
+		SELECT id, IF(user_low_id = ?, user_high_id, user_low_id), UNIX_TIMESTAMP(matched_at),
+			UNIX_TIMESTAMP(unmatched_at), unmatched_by
+		FROM matches
+		WHERE user_low_id = ? OR user_high_id = ?
+		ORDER BY matched_at DESC, id DESC;
+	`
+
+	rows, err := tx.QueryContext(ctx, query, userID, userID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting matches: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Match
+	for rows.Next() {
+		var m Match
+		var unmatchedAt sql.NullInt64
+		var unmatchedBy sql.NullString
+		if err := rows.Scan(&m.ID, &m.UserID, &m.MatchedAtUnix, &unmatchedAt, &unmatchedBy); err != nil {
+			return nil, err
+		}
+		m.UnmatchedAtUnix = unmatchedAt.Int64
+		m.UnmatchedBy = unmatchedBy.String
+		results = append(results, m)
+	}
+	return results, rows.Err()
+}
+
+// exportBlocks returns the blocks the user placed. Blocks placed on the user
+// belong to the blocker and are not exported.
+func exportBlocks(ctx context.Context, tx *sql.Tx, userID string) ([]Block, error) {
+	const query = `
+		SELECT blocked_id, UNIX_TIMESTAMP(created_at)
+		FROM blocks
+		WHERE blocker_id = ?
+		ORDER BY created_at DESC, blocked_id DESC;
+	`
+
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Block
+	for rows.Next() {
+		var b Block
+		if err := rows.Scan(&b.BlockedID, &b.CreatedAtUnix); err != nil {
+			return nil, err
+		}
+		results = append(results, b)
+	}
+	return results, rows.Err()
+}
+
+// EraseUserData deletes every decision, decision event, match and block
+// involving the user plus their seen watermark, the webhook deliveries of
+// their matches and the unpublished outbox events naming them, and
+// anonymizes the user row, which is kept deactivated so the id can not be
+// reused. The counters of the
+// user and everyone they liked are recomputed. Every call is recorded in
+// user_erasures, repeated calls delete whatever was written since and report
+// AlreadyErased.
+func (r *Repository) EraseUserData(ctx context.Context, userID string) (Erasure, error) {
+	const deleteDecisionsQuery = `
+		DELETE FROM decisions WHERE actor_id = ? OR recipient_id = ?;
+	`
+	const deleteDecisionEventsQuery = `
+		DELETE FROM decision_events WHERE actor_id = ? OR recipient_id = ?;
+	`
+	const deleteMatchesQuery = `
+		DELETE FROM matches WHERE user_low_id = ? OR user_high_id = ?;
+	`
+	const deleteBlocksQuery = `
+		DELETE FROM blocks WHERE blocker_id = ? OR blocked_id = ?;
+	`
+	const deleteSeenQuery = `
+		DELETE FROM liked_you_seen WHERE recipient_id = ?;
+	`
+	const deleteWebhookDeliveriesQuery = `
+		DELETE FROM webhook_deliveries
+		WHERE match_id IN (SELECT id FROM matches WHERE user_low_id = ? OR user_high_id = ?);
+	`
+	const deleteOutboxQuery = `
+		DELETE FROM outbox WHERE actor_id = ? OR recipient_id = ?;
+	`
+	const anonymizeQuery = `
+		UPDATE users
+		SET username = ?,
+			deactivated_at = COALESCE(deactivated_at, CURRENT_TIMESTAMP),
+			erased_at = COALESCE(erased_at, CURRENT_TIMESTAMP)
+		WHERE id = ?;
+	`
+	const auditQuery = `
+		INSERT INTO user_erasures (id, user_id, decisions_deleted, matches_deleted, blocks_deleted)
+		VALUES (?, ?, ?, ?, ?);
+	`
+	const requestedAtQuery = `
+		SELECT UNIX_TIMESTAMP(requested_at) FROM user_erasures WHERE id = ?;
+	`
+
+	var erasure Erasure
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		user, err := getUser(ctx, tx, userID, true)
+		if err != nil {
+			return err
+		}
+		erasure = Erasure{ID: uuid.NewString(), UserID: userID, AlreadyErased: user.ErasedAtUnix != 0}
+
+		recipients, err := likedRecipients(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		// the deliveries are found through the matches so they go first
+		if _, err := tx.ExecContext(ctx, deleteWebhookDeliveriesQuery, userID, userID); err != nil {
+			return fmt.Errorf("error erasing user data: %w", err)
+		}
+		deletes := []struct {
+			query string
+			count *int64
+		}{
+			{deleteDecisionsQuery, &erasure.DecisionsDeleted},
+			{deleteMatchesQuery, &erasure.MatchesDeleted},
+			{deleteBlocksQuery, &erasure.BlocksDeleted},
+		}
+		for _, d := range deletes {
+			res, err := tx.ExecContext(ctx, d.query, userID, userID)
+			if err != nil {
+				return fmt.Errorf("error erasing user data: %w", err)
+			}
+			if *d.count, err = res.RowsAffected(); err != nil {
+				return err
+			}
+		}
+		// the history is erased too but only current decisions are counted
+		if _, err := tx.ExecContext(ctx, deleteDecisionEventsQuery, userID, userID); err != nil {
+			return fmt.Errorf("error erasing user data: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, deleteSeenQuery, userID); err != nil {
+			return fmt.Errorf("error erasing user data: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, deleteOutboxQuery, userID, userID); err != nil {
+			return fmt.Errorf("error erasing user data: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, anonymizeQuery, erasedUsernamePrefix+userID, userID); err != nil {
+			return fmt.Errorf("error anonymizing user: %w", err)
+		}
+		if err := refreshLikedYouCounts(ctx, tx, append(recipients, userID)); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, auditQuery, erasure.ID, userID,
+			erasure.DecisionsDeleted, erasure.MatchesDeleted, erasure.BlocksDeleted); err != nil {
+			return fmt.Errorf("error recording erasure: %w", err)
+		}
+		if err := tx.QueryRowContext(ctx, requestedAtQuery, erasure.ID).Scan(&erasure.RequestedAtUnix); err != nil {
+			return fmt.Errorf("error reading erasure: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return Erasure{}, err
+	}
+	return erasure, nil
+}
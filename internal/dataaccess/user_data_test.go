@@ -0,0 +1,136 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_EraseUserData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, username, .* FROM users\\s+WHERE id = \\? FOR UPDATE").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at", "deactivated_at", "erased_at"}).
+			AddRow("user1", "name", int64(1730000000), nil, nil))
+	mock.ExpectQuery("SELECT recipient_id FROM decisions WHERE actor_id = \\? AND liked = TRUE").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id"}).AddRow("user2"))
+	mock.ExpectExec("DELETE FROM webhook_deliveries\\s+WHERE match_id IN \\(SELECT id FROM matches WHERE user_low_id = \\? OR user_high_id = \\?\\)").
+		WithArgs("user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM decisions WHERE actor_id = \\? OR recipient_id = \\?").
+		WithArgs("user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM matches WHERE user_low_id = \\? OR user_high_id = \\?").
+		WithArgs("user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM blocks WHERE blocker_id = \\? OR blocked_id = \\?").
+		WithArgs("user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM decision_events WHERE actor_id = \\? OR recipient_id = \\?").
+		WithArgs("user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec("DELETE FROM liked_you_seen WHERE recipient_id = \\?").
+		WithArgs("user1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM outbox WHERE actor_id = \\? OR recipient_id = \\?").
+		WithArgs("user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec("UPDATE users").
+		WithArgs("erased-user1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO liked_you_counts .* SELECT u.id").
+		WithArgs("user2", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO user_erasures").
+		WithArgs(sqlmock.AnyArg(), "user1", int64(3), int64(1), int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT UNIX_TIMESTAMP\\(requested_at\\) FROM user_erasures").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"requested_at"}).AddRow(int64(1730000200)))
+	mock.ExpectCommit()
+
+	erasure, err := repo.EraseUserData(context.Background(), "user1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if erasure.DecisionsDeleted != 3 || erasure.MatchesDeleted != 1 || erasure.BlocksDeleted != 0 {
+		t.Errorf("unexpected deleted counts: %+v", erasure)
+	}
+	if erasure.AlreadyErased {
+		t.Errorf("expected first erasure not to be marked as already erased")
+	}
+	if erasure.RequestedAtUnix != 1730000200 {
+		t.Errorf("expected requested at 1730000200, got %d", erasure.RequestedAtUnix)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ExportUserData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+	decisionColumns := []string{"actor_id", "recipient_id", "liked", "created_at", "updated_at"}
+	eventColumns := []string{"id", "actor_id", "recipient_id", "liked", "created_at"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, username, .* FROM users\\s+WHERE id = \\?").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at", "deactivated_at", "erased_at"}).
+			AddRow("user1", "name", int64(1730000000), nil, nil))
+	mock.ExpectQuery("FROM decisions\\s+WHERE actor_id = \\?").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows(decisionColumns).AddRow("user1", "user2", true, int64(1730000100), int64(1730000300)))
+	mock.ExpectQuery("FROM decisions\\s+WHERE recipient_id = \\?").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows(decisionColumns))
+	mock.ExpectQuery("SELECT id, actor_id, recipient_id, liked, UNIX_TIMESTAMP\\(created_at\\)\\s+FROM decision_events\\s+WHERE actor_id = \\?\\s+ORDER BY id DESC").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows(eventColumns).
+			AddRow(int64(7), "user1", "user2", true, int64(1730000300)).
+			AddRow(int64(3), "user1", "user2", false, int64(1730000100)))
+	mock.ExpectQuery("FROM decision_events\\s+WHERE recipient_id = \\?").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows(eventColumns).AddRow(int64(5), "user3", "user1", true, int64(1730000200)))
+	mock.ExpectQuery("FROM matches").
+		WithArgs("user1", "user1", "user1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "matched_at", "unmatched_at", "unmatched_by"}))
+	mock.ExpectQuery("FROM blocks").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"blocked_id", "created_at"}))
+	mock.ExpectRollback()
+
+	data, err := repo.ExportUserData(context.Background(), "user1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(data.DecisionsMade) != 1 || len(data.DecisionsReceived) != 0 {
+		t.Errorf("unexpected decisions: %+v", data)
+	}
+	if len(data.EventsMade) != 2 || data.EventsMade[0].ID != 7 || data.EventsMade[1].Liked {
+		t.Errorf("unexpected events made: %+v", data.EventsMade)
+	}
+	if len(data.EventsReceived) != 1 || data.EventsReceived[0].ActorID != "user3" {
+		t.Errorf("unexpected events received: %+v", data.EventsReceived)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL error numbers for constraint violations that map to caller errors.
+const (
+	errDuplicateEntry   = 1062
+	errForeignKeyFailed = 1452
+)
+
+func (r *Repository) CreateUser(ctx context.Context, userID, username string) (User, error) {
+	const query = `
+		INSERT INTO users (id, username) VALUES (?, ?);
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, username); err != nil {
+		if isMySQLError(err, errDuplicateEntry) {
+			return User{}, ErrUsernameTaken
+		}
+		return User{}, fmt.Errorf("error creating user: %w", err)
+	}
+	return getUser(ctx, r.db, userID, false) // read back from the primary, a replica may lag
+}
+
+func (r *Repository) GetUser(ctx context.Context, userID string) (User, error) {
+	return getUser(ctx, r.reader(ctx), userID, false)
+}
+
+// getUser reads the user through q, optionally locking the row for the rest
+// of the transaction.
+func getUser(ctx context.Context, q querier, userID string, forUpdate bool) (User, error) {
+	query := `
+		SELECT id, username, UNIX_TIMESTAMP(created_at), UNIX_TIMESTAMP(deactivated_at), UNIX_TIMESTAMP(erased_at)
+		FROM users
+		WHERE id = ?
+	`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	var u User
+	var deactivatedAt, erasedAt sql.NullInt64
+	err := q.QueryRowContext(ctx, query, userID).Scan(&u.ID, &u.Username, &u.CreatedAtUnix, &deactivatedAt, &erasedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, fmt.Errorf("error getting user: %w", err)
+	}
+	u.DeactivatedAtUnix = deactivatedAt.Int64
+	u.ErasedAtUnix = erasedAt.Int64
+	return u, nil
+}
+
+// DeleteUser removes the user, their decisions, matches and blocks are
+// removed by the ON DELETE CASCADE foreign keys. The counters of everyone the
+// user liked are recomputed in the same transaction.
+func (r *Repository) DeleteUser(ctx context.Context, userID string) error {
+	const query = `
+		DELETE FROM users WHERE id = ?;
+	`
+
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		recipients, err := likedRecipients(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, query, userID)
+		if err != nil {
+			return fmt.Errorf("error deleting user: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error deleting user: %w", err)
+		}
+		if n == 0 {
+			return ErrUserNotFound
+		}
+		return refreshLikedYouCounts(ctx, tx, recipients)
+	})
+}
+
+// SetUserActive deactivates or reactivates the user. Deactivating an
+// already deactivated user keeps the original deactivation time, erased
+// users can not be reactivated. The counters of everyone the user liked are
+// recomputed in the same transaction.
+func (r *Repository) SetUserActive(ctx context.Context, userID string, active bool) (User, error) {
+	query := `
+		UPDATE users SET deactivated_at = COALESCE(deactivated_at, CURRENT_TIMESTAMP) WHERE id = ?;
+	`
+	if active {
+		query = `
+		UPDATE users SET deactivated_at = NULL WHERE id = ? AND erased_at IS NULL;
+	`
+	}
+
+	var user User
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("error updating user: %w", err)
+		}
+
+		var err error
+		user, err = getUser(ctx, tx, userID, false)
+		if err != nil {
+			return err
+		}
+		if active && user.ErasedAtUnix != 0 {
+			return ErrUserErased
+		}
+
+		recipients, err := likedRecipients(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		return refreshLikedYouCounts(ctx, tx, recipients)
+	})
+	if err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+func isMySQLError(err error, number uint16) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == number
+}
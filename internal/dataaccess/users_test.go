@@ -0,0 +1,88 @@
+package dataaccess
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+)
+
+func Test_CreateUser_UsernameTaken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectExec("INSERT INTO users").
+		WithArgs("user1", "taken").
+		WillReturnError(&mysql.MySQLError{Number: errDuplicateEntry, Message: "Duplicate entry 'taken' for key 'users.username'"})
+
+	_, err = repo.CreateUser(context.Background(), "user1", "taken")
+	if !errors.Is(err, ErrUsernameTaken) {
+		t.Errorf("expected ErrUsernameTaken, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_GetUser_Deactivated(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery("SELECT id, username, UNIX_TIMESTAMP\\(created_at\\), UNIX_TIMESTAMP\\(deactivated_at\\)").
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at", "deactivated_at", "erased_at"}).
+			AddRow("user1", "name", int64(1730000000), int64(1730000100), nil))
+
+	user, err := repo.GetUser(context.Background(), "user1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user.DeactivatedAtUnix != 1730000100 {
+		t.Errorf("expected deactivated at 1730000100, got %d", user.DeactivatedAtUnix)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RecordDecisions_UnknownUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT recipient_id, liked FROM decisions .* FOR UPDATE").
+		WithArgs("actor1", "missing").
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "liked"}))
+	mock.ExpectExec("INSERT INTO decisions").
+		WithArgs("actor1", "missing", true).
+		WillReturnError(&mysql.MySQLError{Number: errForeignKeyFailed, Message: "Cannot add or update a child row"})
+	mock.ExpectRollback()
+
+	_, err = repo.RecordDecision(context.Background(), "actor1", "missing", true)
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package dataaccess
+
+// hiddenPairFilter returns a predicate, to append to a WHERE clause, that
+// excludes decisions between users who must no longer see each other:
+// pairs whose match was ended with Unmatch, pairs where either user blocked
+// the other, and decisions made by deactivated users.
+func hiddenPairFilter(actorCol, recipientCol string) string {
+	return `
+		AND NOT EXISTS (
+			SELECT 1 FROM matches AS hm
+			WHERE hm.user_low_id = LEAST(` + actorCol + `, ` + recipientCol + `)
+			  AND hm.user_high_id = GREATEST(` + actorCol + `, ` + recipientCol + `)
+			  AND hm.unmatched_at IS NOT NULL
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM blocks AS hb
+			WHERE hb.blocker_id = ` + recipientCol + ` AND hb.blocked_id = ` + actorCol + `
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM blocks AS hb
+			WHERE hb.blocker_id = ` + actorCol + ` AND hb.blocked_id = ` + recipientCol + `
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM users AS hu
+			WHERE hu.id = ` + actorCol + ` AND hu.deactivated_at IS NOT NULL
+		)`
+}
+
+// outgoingHiddenFilter returns a predicate, to append to a WHERE clause, that
+// excludes the actor's own likes they must no longer see: pairs whose match
+// was ended with Unmatch, recipients the actor blocked and deactivated
+// recipients. Likes to a recipient who blocked the actor stay listed so the
+// actor can't detect the block.
+func outgoingHiddenFilter(actorCol, recipientCol string) string {
+	return `
+		AND NOT EXISTS (
+			SELECT 1 FROM matches AS hm
+			WHERE hm.user_low_id = LEAST(` + actorCol + `, ` + recipientCol + `)
+			  AND hm.user_high_id = GREATEST(` + actorCol + `, ` + recipientCol + `)
+			  AND hm.unmatched_at IS NOT NULL
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM blocks AS hb
+			WHERE hb.blocker_id = ` + actorCol + ` AND hb.blocked_id = ` + recipientCol + `
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM users AS hu
+			WHERE hu.id = ` + recipientCol + ` AND hu.deactivated_at IS NOT NULL
+		)`
+}
@@ -0,0 +1,167 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const webhookDeliveryColumns = `
+	id, event_id, match_id, endpoint_url, payload, status, attempts,
+	COALESCE(last_status_code, 0), COALESCE(last_error, ''),
+	UNIX_TIMESTAMP(created_at), UNIX_TIMESTAMP(updated_at),
+	IF(status = 'pending', FLOOR(UNIX_TIMESTAMP(available_at)), 0)
+`
+
+// EnqueueWebhookDeliveries adds pending deliveries, a delivery of an event to
+// an endpoint that was already enqueued is left as it is, so the same event
+// can be enqueued again safely.
+func (r *Repository) EnqueueWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (event_id, match_id, endpoint_url, payload)
+		VALUES (?, ?, ?, ?)` + strings.Repeat(", (?, ?, ?, ?)", len(deliveries)-1) + `
+		ON DUPLICATE KEY UPDATE id = id;
+	`
+	args := make([]interface{}, 0, len(deliveries)*4)
+	for _, d := range deliveries {
+		args = append(args, d.EventID, d.MatchID, d.EndpointURL, d.Payload)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("error enqueueing webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries that are due,
+// oldest first, and hides them from other dispatchers for the lease.
+func (r *Repository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND available_at <= NOW(6)
+		ORDER BY id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED;
+	`
+
+	var deliveries []WebhookDelivery
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		deliveries, err = scanWebhookDeliveries(tx.QueryContext(ctx, query, limit))
+		if err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(deliveries))
+		for i, d := range deliveries {
+			ids[i] = d.ID
+		}
+		update, args := inIDsQuery(`
+			UPDATE webhook_deliveries SET available_at = NOW(6) + INTERVAL ? MICROSECOND
+			WHERE id IN `, ids, lease.Microseconds())
+		if _, err := tx.ExecContext(ctx, update, args...); err != nil {
+			return fmt.Errorf("error claiming webhook deliveries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt stores the outcome of an attempt at a pending
+// delivery, marking it delivered, dead or due again after attempt.RetryIn.
+func (r *Repository) RecordWebhookAttempt(ctx context.Context, id int64, attempt WebhookAttempt) error {
+	const query = `
+		UPDATE webhook_deliveries
+		SET status = ?, attempts = attempts + 1, last_status_code = NULLIF(?, 0), last_error = NULLIF(?, ''),
+			available_at = NOW(6) + INTERVAL ? MICROSECOND
+		WHERE id = ? AND status = 'pending';
+	`
+	_, err := r.db.ExecContext(ctx, query, attempt.status(), attempt.StatusCode, attempt.Err, attempt.RetryIn.Microseconds(), id)
+	return err
+}
+
+// ListWebhookDeliveries returns one page (plus one extra row to detect a next
+// page) of the deliveries matching the filter, newest first. beforeID resumes
+// after the last delivery of the previous page and is 0 for the first page.
+func (r *Repository) ListWebhookDeliveries(
+	ctx context.Context,
+	filter WebhookDeliveryFilter,
+	beforeID int64,
+	pageSize int,
+) ([]WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE TRUE
+	`
+	var args []interface{}
+	if filter.MatchID != "" {
+		query += " AND match_id = ?"
+		args = append(args, filter.MatchID)
+	}
+	if filter.Status != "" {
+		query += " AND status = ?"
+		args = append(args, string(filter.Status))
+	}
+	if beforeID > 0 {
+		query += " AND id < ?"
+		args = append(args, beforeID)
+	}
+	query += " ORDER BY id DESC LIMIT ?;"
+	args = append(args, pageSize+1) // +1 to check for next page
+
+	deliveries, err := scanWebhookDeliveries(r.reader(ctx).QueryContext(ctx, query, args...))
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func scanWebhookDeliveries(rows *sql.Rows, err error) ([]WebhookDelivery, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []WebhookDelivery
+	for rows.Next() {
+		var d WebhookDelivery
+		var status string
+		err := rows.Scan(
+			&d.ID, &d.EventID, &d.MatchID, &d.EndpointURL, &d.Payload, &status, &d.Attempts,
+			&d.LastStatusCode, &d.LastError,
+			&d.CreatedAtUnix, &d.UpdatedAtUnix, &d.NextAttemptAtUnix,
+		)
+		if err != nil {
+			return nil, err
+		}
+		d.Status = WebhookStatus(status)
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+// status returns the delivery status after the attempt.
+func (a WebhookAttempt) status() WebhookStatus {
+	switch {
+	case a.Err == "":
+		return WebhookDelivered
+	case a.Dead:
+		return WebhookDead
+	default:
+		return WebhookPending
+	}
+}
@@ -0,0 +1,137 @@
+package dataaccess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var webhookDeliveryRows = []string{
+	"id", "event_id", "match_id", "endpoint_url", "payload", "status", "attempts",
+	"last_status_code", "last_error", "created_at", "updated_at", "next_attempt_at",
+}
+
+func Test_EnqueueWebhookDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// enqueueing an event again leaves its deliveries as they are
+	mock.ExpectExec(`INSERT INTO webhook_deliveries \(event_id, match_id, endpoint_url, payload\)\s+VALUES \(\?, \?, \?, \?\), \(\?, \?, \?, \?\)\s+ON DUPLICATE KEY UPDATE id = id`).
+		WithArgs("event1", "match1", "https://a.example", []byte("{}"), "event1", "match1", "https://b.example", []byte("{}")).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	err = repo.EnqueueWebhookDeliveries(context.Background(), []WebhookDelivery{
+		{EventID: "event1", MatchID: "match1", EndpointURL: "https://a.example", Payload: []byte("{}")},
+		{EventID: "event1", MatchID: "match1", EndpointURL: "https://b.example", Payload: []byte("{}")},
+	})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ClaimWebhookDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM webhook_deliveries\s+WHERE status = 'pending' AND available_at <= NOW\(6\)\s+ORDER BY id\s+LIMIT \?\s+FOR UPDATE SKIP LOCKED`).
+		WithArgs(20).
+		WillReturnRows(sqlmock.NewRows(webhookDeliveryRows).
+			AddRow(int64(3), "event1", "match1", "https://a.example", []byte("{}"), "pending", 2, 503, "unexpected status", int64(1730000000), int64(1730000100), int64(1730000200)))
+	mock.ExpectExec(`UPDATE webhook_deliveries SET available_at = NOW\(6\) \+ INTERVAL \? MICROSECOND\s+WHERE id IN \(\?\)`).
+		WithArgs(int64(60000000), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	deliveries, err := repo.ClaimWebhookDeliveries(context.Background(), 20, time.Minute)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Status != WebhookPending || deliveries[0].Attempts != 2 || deliveries[0].LastStatusCode != 503 {
+		t.Errorf("unexpected deliveries: %+v", deliveries)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_RecordWebhookAttempt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	const query = `UPDATE webhook_deliveries\s+SET status = \?, attempts = attempts \+ 1, last_status_code = NULLIF\(\?, 0\), last_error = NULLIF\(\?, ''\),\s+available_at = NOW\(6\) \+ INTERVAL \? MICROSECOND\s+WHERE id = \? AND status = 'pending'`
+	mock.ExpectExec(query).
+		WithArgs(WebhookDelivered, 204, "", int64(0), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(query).
+		WithArgs(WebhookPending, 503, "unexpected status", int64(5000000), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(query).
+		WithArgs(WebhookDead, 0, "connection refused", int64(5000000), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ctx := context.Background()
+	attempts := []WebhookAttempt{
+		{StatusCode: 204},
+		{StatusCode: 503, Err: "unexpected status", RetryIn: 5 * time.Second},
+		{Err: "connection refused", RetryIn: 5 * time.Second, Dead: true},
+	}
+	for i, attempt := range attempts {
+		if err := repo.RecordWebhookAttempt(ctx, int64(i+1), attempt); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_ListWebhookDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`FROM webhook_deliveries\s+WHERE TRUE\s+AND match_id = \? AND status = \? AND id < \? ORDER BY id DESC LIMIT \?`).
+		WithArgs("match1", "dead", int64(10), 21).
+		WillReturnRows(sqlmock.NewRows(webhookDeliveryRows).
+			AddRow(int64(4), "event1", "match1", "https://a.example", []byte("{}"), "dead", 8, 0, "connection refused", int64(1730000000), int64(1730000100), int64(0)))
+
+	deliveries, err := repo.ListWebhookDeliveries(context.Background(), WebhookDeliveryFilter{MatchID: "match1", Status: WebhookDead}, 10, 20)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Status != WebhookDead || deliveries[0].LastError != "connection refused" {
+		t.Errorf("unexpected deliveries: %+v", deliveries)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
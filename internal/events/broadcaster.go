@@ -0,0 +1,68 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Broadcaster fans published events out to every server replica. It assigns
+// each event its Seq and calls the handler of every Subscribe call, on every
+// replica, once per event in Seq order. Handlers must not block.
+type Broadcaster interface {
+	Publish(ctx context.Context, e Event) error
+	Subscribe(handler func(Event)) (cancel func())
+	// Epoch identifies the sequence the Seq values belong to and changes
+	// whenever it restarts, so resume tokens from an old sequence are detected.
+	Epoch() string
+}
+
+// MemoryBroadcaster is the in-process Broadcaster, events only reach the
+// subscribers of the same replica.
+type MemoryBroadcaster struct {
+	mu       sync.Mutex
+	epoch    string
+	seq      uint64
+	nextID   int
+	handlers map[int]func(Event)
+}
+
+func NewMemoryBroadcaster() *MemoryBroadcaster {
+	return &MemoryBroadcaster{
+		epoch:    uuid.NewString(),
+		handlers: map[int]func(Event){},
+	}
+}
+
+// Publish delivers the event to the handlers before returning, holding the
+// lock so every handler sees the events in Seq order.
+func (b *MemoryBroadcaster) Publish(ctx context.Context, e Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	e.Seq = b.seq
+	for _, h := range b.handlers {
+		h(e)
+	}
+	return nil
+}
+
+func (b *MemoryBroadcaster) Subscribe(handler func(Event)) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.handlers[id] = handler
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.handlers, id)
+	}
+}
+
+func (b *MemoryBroadcaster) Epoch() string {
+	return b.epoch
+}
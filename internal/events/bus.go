@@ -0,0 +1,220 @@
+package events
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBufferSize is how many events a watcher can fall behind by before
+	// it is dropped.
+	DefaultBufferSize = 64
+	// DefaultHistorySize is how many recent events are kept to replay to
+	// watchers resuming after a reconnect.
+	DefaultHistorySize = 1024
+)
+
+type Type int
+
+const (
+	// Like is published to the recipient of a new like.
+	Like Type = iota + 1
+	// Match is published to both users of a new match.
+	Match
+	// Resync is only sent to a watcher that resumed after events it can no
+	// longer be given.
+	Resync
+)
+
+// Event is a like or match that landed for RecipientID.
+type Event struct {
+	// Seq is assigned by the Broadcaster and increases with every event.
+	Seq         uint64
+	Type        Type
+	RecipientID string
+	// ActorID is the user who liked the recipient or the other user of the match.
+	ActorID string
+	MatchID string
+	UnixTs  int64
+}
+
+// ErrInvalidToken is returned by Watch for a resume token it can't decode.
+var ErrInvalidToken = errors.New("invalid resume token")
+
+// Bus hands the events of a Broadcaster to the watchers of each recipient.
+// Every watcher has a buffer of bufferSize events, a watcher that falls further
+// behind is dropped rather than slowing down everyone else. The last
+// historySize events are kept so a watcher can resume where it left off.
+type Bus struct {
+	broadcaster Broadcaster
+	bufferSize  int
+	historySize int
+	cancel      func()
+
+	mu       sync.Mutex
+	lastSeq  uint64
+	history  []Event // oldest first
+	watchers map[string]map[*Watcher]struct{}
+}
+
+func NewBus(broadcaster Broadcaster, bufferSize, historySize int) *Bus {
+	b := &Bus{
+		broadcaster: broadcaster,
+		bufferSize:  bufferSize,
+		historySize: historySize,
+		watchers:    map[string]map[*Watcher]struct{}{},
+	}
+	b.cancel = broadcaster.Subscribe(b.deliver)
+	return b
+}
+
+// Publish hands the event to the broadcaster, which assigns its Seq.
+func (b *Bus) Publish(ctx context.Context, e Event) error {
+	return b.broadcaster.Publish(ctx, e)
+}
+
+// Close stops receiving events and drops every watcher.
+func (b *Bus) Close() {
+	b.cancel()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, watchers := range b.watchers {
+		for w := range watchers {
+			b.drop(w)
+		}
+	}
+}
+
+// Token returns the resume token continuing after e.
+func (b *Bus) Token(e Event) string {
+	raw, _ := json.Marshal(resumeToken{Epoch: b.broadcaster.Epoch(), Seq: e.Seq})
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+type resumeToken struct {
+	Epoch string `json:"epoch"`
+	Seq   uint64 `json:"seq"`
+}
+
+// Watch starts a watcher for the recipient's events. With a resume token the
+// events published since are replayed first, if some of them are no longer
+// kept, or the token belongs to another epoch, a single Resync event is sent
+// instead and the watcher continues with new events.
+func (b *Bus) Watch(recipientID, token string) (*Watcher, error) {
+	var resume *resumeToken
+	if token != "" {
+		raw, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+		resume = &resumeToken{}
+		if err := json.Unmarshal(raw, resume); err != nil {
+			return nil, ErrInvalidToken
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	w := &Watcher{bus: b, recipientID: recipientID, ch: make(chan Event, b.bufferSize)}
+	if resume != nil {
+		replay, ok := b.replay(recipientID, *resume)
+		if !ok {
+			replay = []Event{{Seq: b.lastSeq, Type: Resync, RecipientID: recipientID, UnixTs: time.Now().Unix()}}
+		}
+		for _, e := range replay {
+			w.ch <- e
+		}
+	}
+
+	if b.watchers[recipientID] == nil {
+		b.watchers[recipientID] = map[*Watcher]struct{}{}
+	}
+	b.watchers[recipientID][w] = struct{}{}
+	return w, nil
+}
+
+// replay returns the recipient's events after the token, ok is false when
+// they can't all be replayed. Callers must hold the lock.
+func (b *Bus) replay(recipientID string, token resumeToken) ([]Event, bool) {
+	if token.Epoch != b.broadcaster.Epoch() || token.Seq > b.lastSeq {
+		return nil, false
+	}
+	if token.Seq == b.lastSeq {
+		return nil, true
+	}
+	// the event right after the token must still be kept
+	if len(b.history) == 0 || b.history[0].Seq > token.Seq+1 {
+		return nil, false
+	}
+
+	var events []Event
+	for _, e := range b.history {
+		if e.Seq > token.Seq && e.RecipientID == recipientID {
+			events = append(events, e)
+		}
+	}
+	if len(events) > b.bufferSize {
+		return nil, false
+	}
+	return events, true
+}
+
+// deliver is the Broadcaster handler, it never blocks.
+func (b *Bus) deliver(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastSeq = e.Seq
+	if b.historySize > 0 {
+		if len(b.history) == b.historySize {
+			b.history = b.history[1:]
+		}
+		b.history = append(b.history, e)
+	}
+
+	for w := range b.watchers[e.RecipientID] {
+		select {
+		case w.ch <- e:
+		default:
+			b.drop(w) // fell behind
+		}
+	}
+}
+
+// drop unregisters the watcher and closes its channel. Callers must hold the lock.
+func (b *Bus) drop(w *Watcher) {
+	watchers := b.watchers[w.recipientID]
+	if _, ok := watchers[w]; !ok {
+		return
+	}
+	delete(watchers, w)
+	if len(watchers) == 0 {
+		delete(b.watchers, w.recipientID)
+	}
+	close(w.ch)
+}
+
+// Watcher receives the events of one recipient.
+type Watcher struct {
+	bus         *Bus
+	recipientID string
+	ch          chan Event
+}
+
+// Events returns the watcher's events. The channel is closed when the watcher
+// fell behind by more than the buffer size, or was closed.
+func (w *Watcher) Events() <-chan Event {
+	return w.ch
+}
+
+func (w *Watcher) Close() {
+	w.bus.mu.Lock()
+	defer w.bus.mu.Unlock()
+	w.bus.drop(w)
+}
@@ -0,0 +1,150 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func publish(t *testing.T, b *Bus, recipientID, actorID string) {
+	t.Helper()
+	if err := b.Publish(context.Background(), Event{Type: Like, RecipientID: recipientID, ActorID: actorID}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// drain returns the events buffered for the watcher and whether its channel was closed.
+func drain(w *Watcher) ([]Event, bool) {
+	var got []Event
+	for {
+		select {
+		case e, ok := <-w.Events():
+			if !ok {
+				return got, true
+			}
+			got = append(got, e)
+		default:
+			return got, false
+		}
+	}
+}
+
+func actors(events []Event) []string {
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.ActorID)
+	}
+	return ids
+}
+
+func TestBus_DeliversToRecipient(t *testing.T) {
+	b := NewBus(NewMemoryBroadcaster(), 10, 10)
+	defer b.Close()
+
+	w, err := b.Watch("recipient", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	other, _ := b.Watch("other", "")
+
+	publish(t, b, "recipient", "a")
+	publish(t, b, "other", "b")
+	publish(t, b, "recipient", "c")
+
+	got, closed := drain(w)
+	if closed || len(got) != 2 || got[0].ActorID != "a" || got[1].ActorID != "c" {
+		t.Fatalf("expected a then c, got %v (closed %v)", actors(got), closed)
+	}
+	if got[0].Seq >= got[1].Seq {
+		t.Errorf("expected increasing seq, got %d then %d", got[0].Seq, got[1].Seq)
+	}
+	if got, _ := drain(other); len(got) != 1 || got[0].ActorID != "b" {
+		t.Errorf("expected only b for the other recipient, got %v", actors(got))
+	}
+
+	// a closed watcher stops receiving without affecting the others
+	w.Close()
+	publish(t, b, "recipient", "d")
+	if _, closed := drain(w); !closed {
+		t.Errorf("expected closed watcher channel")
+	}
+}
+
+func TestBus_DropsSlowWatcher(t *testing.T) {
+	b := NewBus(NewMemoryBroadcaster(), 2, 10)
+	defer b.Close()
+
+	slow, _ := b.Watch("recipient", "")
+	fast, _ := b.Watch("recipient", "")
+
+	publish(t, b, "recipient", "a")
+	publish(t, b, "recipient", "b")
+	if got, _ := drain(fast); len(got) != 2 {
+		t.Fatalf("expected 2 events, got %v", actors(got))
+	}
+	publish(t, b, "recipient", "c")
+
+	// the slow watcher keeps what it buffered and is then closed
+	got, closed := drain(slow)
+	if !closed || len(got) != 2 {
+		t.Errorf("expected 2 buffered events then close, got %v (closed %v)", actors(got), closed)
+	}
+	if got, closed := drain(fast); closed || len(got) != 1 {
+		t.Errorf("expected fast watcher to keep receiving, got %v (closed %v)", actors(got), closed)
+	}
+}
+
+func TestBus_Resume(t *testing.T) {
+	b := NewBus(NewMemoryBroadcaster(), 3, 4)
+	defer b.Close()
+
+	w, _ := b.Watch("recipient", "")
+	publish(t, b, "recipient", "a")
+	got, _ := drain(w)
+	w.Close()
+	token := b.Token(got[0])
+
+	// missed while disconnected
+	publish(t, b, "recipient", "b")
+	publish(t, b, "other", "x")
+	publish(t, b, "recipient", "c")
+
+	w, err := b.Watch("recipient", token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got, _ = drain(w)
+	if len(got) != 2 || got[0].ActorID != "b" || got[1].ActorID != "c" {
+		t.Fatalf("expected b and c to be replayed, got %v", actors(got))
+	}
+	w.Close()
+
+	// resuming from the latest event replays nothing
+	w, _ = b.Watch("recipient", b.Token(got[1]))
+	if got, _ := drain(w); len(got) != 0 {
+		t.Errorf("expected nothing to replay, got %v", actors(got))
+	}
+	w.Close()
+
+	// once a after the token was evicted from history the watcher has to resync
+	publish(t, b, "recipient", "d")
+	publish(t, b, "recipient", "e")
+	w, _ = b.Watch("recipient", token)
+	got, _ = drain(w)
+	if len(got) != 1 || got[0].Type != Resync {
+		t.Errorf("expected a single resync, got %+v", got)
+	}
+	w.Close()
+
+	// tokens of another epoch, e.g. issued before a restart, resync as well
+	restarted := NewBus(NewMemoryBroadcaster(), 3, 4)
+	defer restarted.Close()
+	w, _ = restarted.Watch("recipient", token)
+	if got, _ := drain(w); len(got) != 1 || got[0].Type != Resync {
+		t.Errorf("expected a single resync, got %+v", got)
+	}
+
+	if _, err := b.Watch("recipient", "!!"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken, got %v", err)
+	}
+}
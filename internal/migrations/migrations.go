@@ -0,0 +1,317 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+const (
+	// lockName is the MySQL advisory lock held while migrating so several
+	// replicas starting at once apply each migration exactly once.
+	lockName        = "explore_schema_migrations"
+	lockWaitSeconds = 60
+)
+
+const createTrackingTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (version)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+`
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Load returns the embedded migrations ordered by version.
+// Files are named <version>_<name>.up.sql and <version>_<name>.down.sql.
+func Load() ([]Migration, error) {
+	entries, err := fs.ReadDir(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		fileName := e.Name()
+
+		var direction string
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(fileName, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("migration %s must end in .up.sql or .down.sql", fileName)
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s must be named <version>_<name>", fileName)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has an invalid version: %w", fileName, err)
+		}
+
+		content, err := fs.ReadFile(files, path.Join("sql", fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if m.Name != name {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	var migrations []Migration
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Migrator applies the embedded migrations to a MySQL database.
+// With DryRun set the statements are written to Out instead of executed.
+type Migrator struct {
+	db     *sql.DB
+	DryRun bool
+	Out    io.Writer
+}
+
+func NewMigrator(db *sql.DB) *Migrator {
+	return &Migrator{db: db, Out: io.Discard}
+}
+
+// Up applies every pending migration in version order. MySQL commits DDL
+// implicitly, so a migration and its schema_migrations row can't be written
+// atomically. Every script must be safe to run again after a crash in between:
+// CREATE ... IF NOT EXISTS, ALTERs guarded by an information_schema check and
+// upserts instead of plain INSERTs.
+func (m *Migrator) Up(ctx context.Context) error {
+	migrations, err := Load()
+	if err != nil {
+		return err
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			fmt.Fprintf(m.Out, "applying %04d_%s\n", mig.Version, mig.Name)
+			if err := m.exec(ctx, conn, mig.Up); err != nil {
+				return fmt.Errorf("error applying migration %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+			if err := m.exec(ctx, conn, "INSERT INTO schema_migrations (version, name) VALUES (?, ?);", mig.Version, mig.Name); err != nil {
+				return fmt.Errorf("error recording migration %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down rolls back the latest steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	migrations, err := Load()
+	if err != nil {
+		return err
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+			mig := migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			fmt.Fprintf(m.Out, "rolling back %04d_%s\n", mig.Version, mig.Name)
+			if err := m.exec(ctx, conn, mig.Down); err != nil {
+				return fmt.Errorf("error rolling back migration %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+			if err := m.exec(ctx, conn, "DELETE FROM schema_migrations WHERE version = ?;", mig.Version); err != nil {
+				return fmt.Errorf("error unrecording migration %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Status reports every embedded migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, mig := range migrations {
+		appliedAt, ok := applied[mig.Version]
+		statuses = append(statuses, Status{Migration: mig, Applied: ok, AppliedAt: appliedAt})
+	}
+	return statuses, nil
+}
+
+// withLock runs fn on a single connection holding the advisory migration lock.
+// GET_LOCK is connection scoped, so every statement must go through conn.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?);", lockName, lockWaitSeconds).Scan(&locked); err != nil {
+		return fmt.Errorf("error acquiring migration lock: %w", err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return errors.New("timed out waiting for migration lock")
+	}
+	defer func() {
+		if _, releaseErr := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?);", lockName); releaseErr != nil && err == nil {
+			err = fmt.Errorf("error releasing migration lock: %w", releaseErr)
+		}
+	}()
+
+	if err := m.exec(ctx, conn, createTrackingTable); err != nil {
+		return fmt.Errorf("error creating schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) exec(ctx context.Context, conn *sql.Conn, script string, args ...interface{}) error {
+	for _, stmt := range splitStatements(script) {
+		if m.DryRun {
+			if len(args) > 0 {
+				fmt.Fprintf(m.Out, "%s; -- args: %v\n", stmt, args)
+			} else {
+				fmt.Fprintf(m.Out, "%s;\n", stmt)
+			}
+			continue
+		}
+		if _, err := conn.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appliedVersions returns the applied migration versions, or none when the
+// tracking table does not exist yet (only possible on a dry run).
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	const existsQuery = `
+		SELECT COUNT(*) FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_name = 'schema_migrations';
+	`
+	var exists int
+	if err := conn.QueryRowContext(ctx, existsQuery).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("error checking for schema_migrations: %w", err)
+	}
+
+	applied := map[int64]time.Time{}
+	if exists == 0 {
+		return applied, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations;")
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// splitStatements splits a migration script on the semicolons ending each
+// statement, the MySQL driver only runs one statement per Exec.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
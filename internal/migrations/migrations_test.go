@@ -0,0 +1,149 @@
+package migrations
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_Load(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatalf("expected embedded migrations")
+	}
+	for i, m := range migrations {
+		if i > 0 && m.Version <= migrations[i-1].Version {
+			t.Errorf("migrations out of order: %d after %d", m.Version, migrations[i-1].Version)
+		}
+		if len(splitStatements(m.Up)) == 0 || len(splitStatements(m.Down)) == 0 {
+			t.Errorf("migration %04d_%s has an empty up or down script", m.Version, m.Name)
+		}
+	}
+}
+
+// Test_Load_Rerunnable checks every script only uses statements that are safe
+// to run again, see Migrator.Up.
+func Test_Load_Rerunnable(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, m := range migrations {
+		for _, script := range []string{m.Up, m.Down} {
+			for _, stmt := range splitStatements(script) {
+				upper := strings.ToUpper(stmt)
+				switch {
+				case strings.HasPrefix(upper, "ALTER "):
+					t.Errorf("migration %04d_%s has an unguarded ALTER: %q", m.Version, m.Name, stmt)
+				case strings.HasPrefix(upper, "CREATE TABLE") && !strings.HasPrefix(upper, "CREATE TABLE IF NOT EXISTS"):
+					t.Errorf("migration %04d_%s creates a table without IF NOT EXISTS: %q", m.Version, m.Name, stmt)
+				case strings.HasPrefix(upper, "DROP TABLE") && !strings.HasPrefix(upper, "DROP TABLE IF EXISTS"):
+					t.Errorf("migration %04d_%s drops a table without IF EXISTS: %q", m.Version, m.Name, stmt)
+				case strings.HasPrefix(upper, "INSERT ") && !strings.Contains(upper, "ON DUPLICATE KEY UPDATE"):
+					t.Errorf("migration %04d_%s has a plain INSERT: %q", m.Version, m.Name, stmt)
+				}
+			}
+		}
+	}
+}
+
+func Test_splitStatements(t *testing.T) {
+	script := `
+-- comment
+CREATE TABLE a (
+  id INT
+);
+
+ALTER TABLE a ADD COLUMN b INT;
+`
+	got := splitStatements(script)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 statements, got %d: %q", len(got), got)
+	}
+	if !strings.HasPrefix(got[0], "CREATE TABLE a (") || strings.HasSuffix(got[0], ";") {
+		t.Errorf("unexpected first statement %q", got[0])
+	}
+	if got[1] != "ALTER TABLE a ADD COLUMN b INT" {
+		t.Errorf("unexpected second statement %q", got[1])
+	}
+}
+
+func Test_Up_SkipsAppliedMigrations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	mock.ExpectQuery("SELECT GET_LOCK").
+		WithArgs(lockName, lockWaitSeconds).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("FROM information_schema.tables").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// every migration but the last one is already applied
+	applied := sqlmock.NewRows([]string{"version", "applied_at"})
+	for _, m := range migrations[:len(migrations)-1] {
+		applied.AddRow(m.Version, time.Now())
+	}
+	mock.ExpectQuery("SELECT version, applied_at FROM schema_migrations").
+		WillReturnRows(applied)
+
+	last := migrations[len(migrations)-1]
+	for range splitStatements(last.Up) {
+		mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectExec("INSERT INTO schema_migrations").
+		WithArgs(last.Version, last.Name).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("SELECT RELEASE_LOCK").
+		WithArgs(lockName).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	var out bytes.Buffer
+	migrator := NewMigrator(db)
+	migrator.Out = &out
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(out.String(), last.Name) {
+		t.Errorf("expected output to mention %s, got %q", last.Name, out.String())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func Test_Up_LockTimeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT GET_LOCK").
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(0))
+
+	if err := NewMigrator(db).Up(context.Background()); err == nil {
+		t.Errorf("expected lock timeout error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
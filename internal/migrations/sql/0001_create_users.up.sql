@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS users (
+  id CHAR(36) NOT NULL,
+  username VARCHAR(50) NOT NULL UNIQUE,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
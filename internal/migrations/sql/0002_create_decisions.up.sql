@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS decisions (
+  actor_id CHAR(36) NOT NULL,
+  recipient_id CHAR(36) NOT NULL,
+  liked BOOLEAN NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (actor_id, recipient_id),
+  INDEX idx_recipient_liked (recipient_id, liked, updated_at DESC),
+  INDEX idx_pair_recipient_actor (recipient_id, actor_id, liked),
+
+  CONSTRAINT fk_actor FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_recipient FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,15 @@
+-- user_low_id/user_high_id hold the pair in a canonical order so each pair has one row
+CREATE TABLE IF NOT EXISTS matches (
+  id CHAR(36) NOT NULL,
+  user_low_id CHAR(36) NOT NULL,
+  user_high_id CHAR(36) NOT NULL,
+  matched_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_match_pair (user_low_id, user_high_id),
+  INDEX idx_low_matched (user_low_id, matched_at DESC),
+  INDEX idx_high_matched (user_high_id, matched_at DESC),
+
+  CONSTRAINT fk_match_low FOREIGN KEY (user_low_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_match_high FOREIGN KEY (user_high_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,9 @@
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'matches' AND column_name = 'unmatched_at') > 0,
+  'ALTER TABLE matches DROP FOREIGN KEY fk_match_unmatched_by, DROP COLUMN unmatched_by, DROP COLUMN unmatched_at',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
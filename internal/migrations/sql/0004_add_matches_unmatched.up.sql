@@ -0,0 +1,10 @@
+-- Unmatch records when and by whom a match ended
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'matches' AND column_name = 'unmatched_at') = 0,
+  'ALTER TABLE matches ADD COLUMN unmatched_at DATETIME NULL, ADD COLUMN unmatched_by CHAR(36) NULL, ADD CONSTRAINT fk_match_unmatched_by FOREIGN KEY (unmatched_by) REFERENCES users(id) ON DELETE SET NULL',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
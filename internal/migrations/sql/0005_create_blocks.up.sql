@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS blocks (
+  blocker_id CHAR(36) NOT NULL,
+  blocked_id CHAR(36) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (blocker_id, blocked_id),
+  INDEX idx_blocker_created (blocker_id, created_at DESC),
+
+  CONSTRAINT fk_blocker FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_blocked FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
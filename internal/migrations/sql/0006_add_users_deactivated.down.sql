@@ -0,0 +1,9 @@
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'users' AND column_name = 'deactivated_at') > 0,
+  'ALTER TABLE users DROP COLUMN deactivated_at',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
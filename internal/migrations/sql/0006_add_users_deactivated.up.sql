@@ -0,0 +1,10 @@
+-- DeactivateUser hides a user's likes while deactivated_at is set
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'users' AND column_name = 'deactivated_at') = 0,
+  'ALTER TABLE users ADD COLUMN deactivated_at DATETIME NULL',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
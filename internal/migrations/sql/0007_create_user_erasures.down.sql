@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS user_erasures;
+
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'users' AND column_name = 'erased_at') > 0,
+  'ALTER TABLE users DROP COLUMN erased_at',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,23 @@
+-- EraseUserData keeps the anonymized user row, marked by erased_at
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'users' AND column_name = 'erased_at') = 0,
+  'ALTER TABLE users ADD COLUMN erased_at DATETIME NULL',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+-- audit trail of privacy erasure requests, kept without a foreign key so it outlives the user row
+CREATE TABLE IF NOT EXISTS user_erasures (
+  id CHAR(36) NOT NULL,
+  user_id CHAR(36) NOT NULL,
+  requested_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  decisions_deleted INT UNSIGNED NOT NULL,
+  matches_deleted INT UNSIGNED NOT NULL,
+  blocks_deleted INT UNSIGNED NOT NULL,
+
+  PRIMARY KEY (id),
+  INDEX idx_erasure_user (user_id, requested_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
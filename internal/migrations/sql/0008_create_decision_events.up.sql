@@ -0,0 +1,14 @@
+-- append-only log of every decision, written in the same transaction as the decisions upsert
+CREATE TABLE IF NOT EXISTS decision_events (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  actor_id CHAR(36) NOT NULL,
+  recipient_id CHAR(36) NOT NULL,
+  liked BOOLEAN NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (id),
+  INDEX idx_event_pair (actor_id, recipient_id, id DESC),
+
+  CONSTRAINT fk_event_actor FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_event_recipient FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
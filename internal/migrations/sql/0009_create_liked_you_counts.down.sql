@@ -0,0 +1 @@
+DROP TABLE IF EXISTS liked_you_counts;
@@ -0,0 +1,27 @@
+-- maintained CountLikedYou counters, liked_count is signed so a drifted counter can't wrap around
+CREATE TABLE IF NOT EXISTS liked_you_counts (
+  recipient_id CHAR(36) NOT NULL,
+  liked_count BIGINT NOT NULL DEFAULT 0,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (recipient_id),
+
+  CONSTRAINT fk_count_recipient FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- backfill from the visible likes, the same predicate as hiddenPairFilter, recomputing any counter a previous run left
+INSERT INTO liked_you_counts (recipient_id, liked_count)
+SELECT d.recipient_id, COUNT(*)
+FROM decisions AS d
+WHERE d.liked = TRUE
+  AND NOT EXISTS (
+    SELECT 1 FROM matches AS hm
+    WHERE hm.user_low_id = LEAST(d.actor_id, d.recipient_id)
+      AND hm.user_high_id = GREATEST(d.actor_id, d.recipient_id)
+      AND hm.unmatched_at IS NOT NULL
+  )
+  AND NOT EXISTS (SELECT 1 FROM blocks AS hb WHERE hb.blocker_id = d.recipient_id AND hb.blocked_id = d.actor_id)
+  AND NOT EXISTS (SELECT 1 FROM blocks AS hb WHERE hb.blocker_id = d.actor_id AND hb.blocked_id = d.recipient_id)
+  AND NOT EXISTS (SELECT 1 FROM users AS hu WHERE hu.id = d.actor_id AND hu.deactivated_at IS NOT NULL)
+GROUP BY d.recipient_id
+ON DUPLICATE KEY UPDATE liked_count = VALUES(liked_count);
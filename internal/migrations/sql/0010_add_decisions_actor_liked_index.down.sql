@@ -0,0 +1,9 @@
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.statistics
+   WHERE table_schema = DATABASE() AND table_name = 'decisions' AND index_name = 'idx_actor_liked') > 0,
+  'ALTER TABLE decisions DROP INDEX idx_actor_liked',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,10 @@
+-- ListYouLiked and CountYouLiked page through an actor's likes by updated_at
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.statistics
+   WHERE table_schema = DATABASE() AND table_name = 'decisions' AND index_name = 'idx_actor_liked') = 0,
+  'ALTER TABLE decisions ADD INDEX idx_actor_liked (actor_id, liked, updated_at DESC)',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
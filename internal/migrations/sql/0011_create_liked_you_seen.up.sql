@@ -0,0 +1,11 @@
+-- per recipient watermark of the newest like they have seen, in (updated_at, actor_id) order
+CREATE TABLE IF NOT EXISTS liked_you_seen (
+  recipient_id CHAR(36) NOT NULL,
+  seen_updated_at DATETIME NOT NULL,
+  seen_actor_id CHAR(36) NOT NULL,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (recipient_id),
+
+  CONSTRAINT fk_seen_recipient FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
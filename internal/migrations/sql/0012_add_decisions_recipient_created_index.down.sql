@@ -0,0 +1,9 @@
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.statistics
+   WHERE table_schema = DATABASE() AND table_name = 'decisions' AND index_name = 'idx_recipient_liked_created') > 0,
+  'ALTER TABLE decisions DROP INDEX idx_recipient_liked_created',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
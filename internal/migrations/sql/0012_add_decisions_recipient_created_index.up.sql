@@ -0,0 +1,10 @@
+-- ListLikedYou and ListNewLikedYou order by first-liked time by default
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.statistics
+   WHERE table_schema = DATABASE() AND table_name = 'decisions' AND index_name = 'idx_recipient_liked_created') = 0,
+  'ALTER TABLE decisions ADD INDEX idx_recipient_liked_created (recipient_id, liked, created_at DESC)',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,17 @@
+-- events to publish, written in the same transaction as the change they describe and deleted once published
+CREATE TABLE IF NOT EXISTS outbox (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  event_id CHAR(36) NOT NULL,
+  event_type VARCHAR(255) NOT NULL,
+  event_key VARCHAR(255) NOT NULL,
+  payload BLOB NOT NULL,
+  attempts INT UNSIGNED NOT NULL DEFAULT 0,
+  last_error TEXT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  -- claimed and failed events are hidden from the relay until then
+  available_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_outbox_event_id (event_id),
+  INDEX idx_outbox_available (available_at, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,21 @@
+-- one row per match event and webhook endpoint, kept after delivery as its status
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  event_id CHAR(36) NOT NULL,
+  match_id CHAR(36) NOT NULL,
+  endpoint_url VARCHAR(512) NOT NULL,
+  payload BLOB NOT NULL,
+  status VARCHAR(16) NOT NULL DEFAULT 'pending',
+  attempts INT UNSIGNED NOT NULL DEFAULT 0,
+  last_status_code INT UNSIGNED NULL,
+  last_error TEXT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  -- pending deliveries are hidden from the dispatcher until then
+  available_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_delivery_event_endpoint (event_id, endpoint_url),
+  INDEX idx_delivery_status (status, id),
+  INDEX idx_delivery_match (match_id, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,10 @@
+-- daily like quotas count the recipients the actor liked within the last day
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.statistics
+   WHERE table_schema = DATABASE() AND table_name = 'decision_events' AND index_name = 'idx_event_actor_liked') = 0,
+  'ALTER TABLE decision_events ADD INDEX idx_event_actor_liked (actor_id, liked, created_at, recipient_id)',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,9 @@
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'outbox' AND column_name = 'actor_id') > 0,
+  'ALTER TABLE outbox DROP COLUMN actor_id, DROP COLUMN recipient_id',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
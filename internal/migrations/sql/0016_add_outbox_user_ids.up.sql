@@ -0,0 +1,14 @@
+-- EraseUserData deletes the unpublished events about a user, events written before this have neither
+SET @ddl = IF(
+  (SELECT COUNT(*) FROM information_schema.columns
+   WHERE table_schema = DATABASE() AND table_name = 'outbox' AND column_name = 'actor_id') = 0,
+  'ALTER TABLE outbox
+     ADD COLUMN actor_id CHAR(36) NULL AFTER event_key,
+     ADD COLUMN recipient_id CHAR(36) NULL AFTER actor_id,
+     ADD INDEX idx_outbox_actor (actor_id),
+     ADD INDEX idx_outbox_recipient (recipient_id)',
+  'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
@@ -0,0 +1,126 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	// registers the event messages the NDJSONPublisher decodes
+	_ "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Message is an outbox event handed to a Publisher.
+type Message struct {
+	// ID is unique per event, consumers deduplicate redelivered events on it.
+	ID string
+	// Type is the full protobuf name of the Payload message, e.g. explore.MatchCreated.
+	Type string
+	// Key groups related events, the actor id of decisions and the match id of matches.
+	Key           string
+	Payload       []byte
+	CreatedAtUnix int64
+}
+
+// Publisher delivers messages downstream. A message is only deleted from the
+// outbox once Publish returned nil, so it may be called again for a message
+// that was already delivered.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// MultiPublisher publishes every message to each of the publishers in turn.
+// A failure is retried on all of them, so the others may see the message again.
+type MultiPublisher []Publisher
+
+func (p MultiPublisher) Publish(ctx context.Context, msg Message) error {
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemoryPublisher keeps every published message, for local runs and tests.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+// Messages returns the messages published so far, oldest first.
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.messages...)
+}
+
+// NDJSONPublisher writes every message as one line of JSON, with the payload
+// in its protobuf JSON form.
+type NDJSONPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewNDJSONPublisher(w io.Writer) *NDJSONPublisher {
+	return &NDJSONPublisher{w: w}
+}
+
+type ndjsonLine struct {
+	ID                   string          `json:"id"`
+	Type                 string          `json:"type"`
+	Key                  string          `json:"key"`
+	CreatedUnixTimestamp int64           `json:"created_unix_timestamp"`
+	Payload              json.RawMessage `json:"payload"`
+}
+
+func (p *NDJSONPublisher) Publish(ctx context.Context, msg Message) error {
+	payload, err := payloadJSON(msg)
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(ndjsonLine{
+		ID:                   msg.ID,
+		Type:                 msg.Type,
+		Key:                  msg.Key,
+		CreatedUnixTimestamp: msg.CreatedAtUnix,
+		Payload:              payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err = p.w.Write(append(line, '\n'))
+	return err
+}
+
+// payloadJSON decodes the payload as its Type and returns its protobuf JSON form.
+func payloadJSON(msg Message) ([]byte, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(msg.Type))
+	if err != nil {
+		return nil, fmt.Errorf("unknown event type %q: %w", msg.Type, err)
+	}
+	payload := mt.New().Interface()
+	if err := proto.Unmarshal(msg.Payload, payload); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", msg.Type, err)
+	}
+	return protojson.Marshal(payload)
+}
@@ -0,0 +1,44 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNDJSONPublisher(t *testing.T) {
+	payload, _ := proto.Marshal(&pb.DecisionRecorded{
+		EventId:         "e1",
+		ActorUserId:     "a",
+		RecipientUserId: "b",
+		LikedRecipient:  true,
+		UnixTimestamp:   1730000000,
+	})
+
+	var buf bytes.Buffer
+	p := NewNDJSONPublisher(&buf)
+	for i := 0; i < 2; i++ {
+		err := p.Publish(context.Background(), Message{ID: "e1", Type: "explore.DecisionRecorded", Key: "a", Payload: payload, CreatedAtUnix: 1730000001})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", buf.String())
+	}
+	for _, want := range []string{`"id":"e1"`, `"type":"explore.DecisionRecorded"`, `"created_unix_timestamp":1730000001`, `"actorUserId":"a"`, `"likedRecipient":true`} {
+		if !strings.Contains(strings.ReplaceAll(lines[0], " ", ""), want) {
+			t.Errorf("expected %s in %s", want, lines[0])
+		}
+	}
+
+	if err := p.Publish(context.Background(), Message{ID: "e2", Type: "explore.Unknown"}); err == nil {
+		t.Errorf("expected an error for an unknown type")
+	}
+}
@@ -0,0 +1,124 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+)
+
+// Source is the outbox the Relay publishes from, dataaccess.Store implements it.
+type Source interface {
+	ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]dataaccess.OutboxEvent, error)
+	RetryOutboxEvent(ctx context.Context, id int64, delay time.Duration, lastErr string) error
+	DeleteOutboxEvents(ctx context.Context, ids []int64) error
+}
+
+type Config struct {
+	// BatchSize is how many events are claimed at a time.
+	BatchSize int
+	// PollInterval is how long the relay waits after finding fewer than BatchSize events.
+	PollInterval time.Duration
+	// Lease hides claimed events from other relays, it must comfortably cover
+	// publishing a whole batch or the events are published twice.
+	Lease time.Duration
+	// MinBackoff is the delay before retrying an event the first time, it
+	// doubles with every failed attempt up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig returns the relay settings used by the server.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: time.Second,
+		Lease:        time.Minute,
+		MinBackoff:   time.Second,
+		MaxBackoff:   5 * time.Minute,
+	}
+}
+
+// Relay moves events from the outbox to a Publisher. Events are deleted once
+// published, so every event is delivered at least once, and more than once
+// when the relay stops between publishing and deleting it. Events that fail
+// are retried with exponential backoff without holding up the others, so
+// consumers can't rely on the delivery order.
+type Relay struct {
+	source    Source
+	publisher Publisher
+	cfg       Config
+}
+
+func NewRelay(source Source, publisher Publisher, cfg Config) *Relay {
+	return &Relay{source: source, publisher: publisher, cfg: cfg}
+}
+
+// Run relays events until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		claimed, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("outbox relay error: %v", err)
+		}
+		if err == nil && claimed == r.cfg.BatchSize {
+			continue // more are waiting
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// RelayOnce publishes one batch of events and returns how many were claimed.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	events, err := r.source.ClaimOutboxEvents(ctx, r.cfg.BatchSize, r.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	var published []int64
+	for _, e := range events {
+		err := r.publisher.Publish(ctx, Message{
+			ID:            e.EventID,
+			Type:          e.Type,
+			Key:           e.Key,
+			Payload:       e.Payload,
+			CreatedAtUnix: e.CreatedAtUnix,
+		})
+		if err == nil {
+			published = append(published, e.ID)
+			continue
+		}
+
+		log.Printf("failed to publish outbox event %s (attempt %d): %v", e.EventID, e.Attempts+1, err)
+		if err := r.source.RetryOutboxEvent(ctx, e.ID, r.backoff(e.Attempts), err.Error()); err != nil {
+			// the lease runs out and the event is retried anyway
+			log.Printf("failed to reschedule outbox event %s: %v", e.EventID, err)
+		}
+	}
+
+	if err := r.source.DeleteOutboxEvents(ctx, published); err != nil {
+		return len(events), err
+	}
+	return len(events), nil
+}
+
+// backoff returns the delay before retrying an event that already failed
+// attempts times before this failure.
+func (r *Relay) backoff(attempts int) time.Duration {
+	return Backoff(r.cfg.MinBackoff, r.cfg.MaxBackoff, attempts)
+}
+
+// Backoff returns minDelay doubled for every previous attempt, up to maxDelay.
+func Backoff(minDelay, maxDelay time.Duration, attempts int) time.Duration {
+	delay := minDelay
+	for i := 0; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,153 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeSource hands out its events once and records what the relay did with them.
+type fakeSource struct {
+	events  []dataaccess.OutboxEvent
+	retried map[int64]time.Duration
+	deleted []int64
+}
+
+func (s *fakeSource) ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]dataaccess.OutboxEvent, error) {
+	events := s.events[:min(limit, len(s.events))]
+	s.events = s.events[len(events):]
+	return events, nil
+}
+
+func (s *fakeSource) RetryOutboxEvent(ctx context.Context, id int64, delay time.Duration, lastErr string) error {
+	s.retried[id] = delay
+	return nil
+}
+
+func (s *fakeSource) DeleteOutboxEvents(ctx context.Context, ids []int64) error {
+	s.deleted = append(s.deleted, ids...)
+	return nil
+}
+
+// failingPublisher fails the messages with the listed ids.
+type failingPublisher struct {
+	MemoryPublisher
+	fail map[string]bool
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, msg Message) error {
+	if p.fail[msg.ID] {
+		return errors.New("unavailable")
+	}
+	return p.MemoryPublisher.Publish(ctx, msg)
+}
+
+func testConfig() Config {
+	return Config{BatchSize: 10, PollInterval: time.Millisecond, Lease: time.Minute, MinBackoff: time.Second, MaxBackoff: time.Minute}
+}
+
+func TestRelay_PublishesDecisionsAndMatches(t *testing.T) {
+	ctx := context.Background()
+	repo := dataaccess.NewMemoryRepository()
+	for _, id := range []string{"a", "b"} {
+		if _, err := repo.CreateUser(ctx, id, "username-"+id); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	_, _ = repo.RecordDecision(ctx, "a", "b", true)
+	result, _ := repo.RecordDecision(ctx, "b", "a", true)
+
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(repo, publisher, testConfig())
+	claimed, err := relay.RelayOnce(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if claimed != 3 {
+		t.Fatalf("expected 3 events, got %d", claimed)
+	}
+
+	messages := publisher.Messages()
+	var match pb.MatchCreated
+	if err := proto.Unmarshal(messages[2].Payload, &match); err != nil {
+		t.Fatalf("failed to decode match: %v", err)
+	}
+	if messages[2].Type != "explore.MatchCreated" || match.MatchId != result.Match.ID || match.ActorUserId != "b" || match.EventId != messages[2].ID {
+		t.Errorf("unexpected match event %s: %v", messages[2].Type, &match)
+	}
+
+	// published events are gone from the outbox
+	if claimed, _ := relay.RelayOnce(ctx); claimed != 0 {
+		t.Errorf("expected nothing left to publish, got %d", claimed)
+	}
+}
+
+func TestRelay_RetriesFailedEvents(t *testing.T) {
+	source := &fakeSource{
+		events: []dataaccess.OutboxEvent{
+			{ID: 1, EventID: "e1"},
+			{ID: 2, EventID: "e2", Attempts: 2},
+			{ID: 3, EventID: "e3"},
+		},
+		retried: map[int64]time.Duration{},
+	}
+	publisher := &failingPublisher{fail: map[string]bool{"e2": true}}
+
+	// a failing event doesn't hold up the others
+	if _, err := NewRelay(source, publisher, testConfig()).RelayOnce(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(source.deleted) != 2 || source.deleted[0] != 1 || source.deleted[1] != 3 {
+		t.Errorf("expected e1 and e3 to be deleted, got %v", source.deleted)
+	}
+	if delay := source.retried[2]; delay != 4*time.Second {
+		t.Errorf("expected e2 to be retried in 4s, got %v", delay)
+	}
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	relay := NewRelay(nil, nil, testConfig())
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{5, 32 * time.Second},
+		{6, time.Minute},
+		{100, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := relay.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d): expected %v, got %v", tt.attempts, tt.want, got)
+		}
+	}
+}
+
+func TestRelay_Run(t *testing.T) {
+	source := &fakeSource{events: []dataaccess.OutboxEvent{{ID: 1, EventID: "e1"}}, retried: map[int64]time.Duration{}}
+	publisher := NewMemoryPublisher()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewRelay(source, publisher, testConfig()).Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.After(time.Second)
+	for len(publisher.Messages()) == 0 {
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for the relay")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	cancel()
+	<-done
+}
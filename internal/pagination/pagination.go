@@ -2,20 +2,65 @@ package pagination
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"strconv"
 )
 
-func Encode(unixTs int64) string {
-	return base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(unixTs, 10)))
+// Cursor is the keyset position of the last row returned on a page.
+// Rows are ordered by (UnixTs DESC, ID DESC) so the ID breaks ties between
+// rows sharing the same second.
+type Cursor struct {
+	UnixTs int64  `json:"ts"`
+	ID     string `json:"id,omitempty"`
+	// Order names the ordering of the list the cursor was taken from, empty
+	// for lists with a single ordering and for tokens issued before a list
+	// had several.
+	Order string `json:"order,omitempty"`
 }
 
-func Decode(token string) (int64, error) {
+// IsZero reports whether the cursor points at the start of the list.
+func (c Cursor) IsZero() bool {
+	return c.UnixTs == 0 && c.ID == ""
+}
+
+// IsLegacy reports whether the cursor was decoded from an old timestamp-only
+// token, which can only be resumed with a strict timestamp comparison.
+func (c Cursor) IsLegacy() bool {
+	return c.UnixTs > 0 && c.ID == ""
+}
+
+// Less reports whether c sorts before o in (UnixTs, ID) order, that is
+// whether the row at o is newer than the row at c.
+func (c Cursor) Less(o Cursor) bool {
+	return c.UnixTs < o.UnixTs || (c.UnixTs == o.UnixTs && c.ID < o.ID)
+}
+
+func Encode(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func Decode(token string) (Cursor, error) {
 	if token == "" {
-		return 0, nil
+		return Cursor{}, nil
 	}
 	decoded, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
-		return 0, err
+		return Cursor{}, err
+	}
+
+	// old tokens are a bare unix timestamp, keep accepting them until clients have moved over
+	if unixTs, err := strconv.ParseInt(string(decoded), 10, 64); err == nil {
+		return Cursor{UnixTs: unixTs}, nil
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(decoded, &c); err != nil {
+		return Cursor{}, err
+	}
+	if c.UnixTs <= 0 || c.ID == "" {
+		return Cursor{}, errors.New("pagination token is missing its position")
 	}
-	return strconv.ParseInt(string(decoded), 10, 64)
+	return c, nil
 }
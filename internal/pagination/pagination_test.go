@@ -0,0 +1,95 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	want := Cursor{UnixTs: 1730000000, ID: "550e8400-e29b-41d4-a716-446655440000"}
+
+	got, err := Decode(Encode(want))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    Cursor
+		wantErr bool
+	}{
+		{
+			name:  "empty token starts from the beginning",
+			token: "",
+			want:  Cursor{},
+		},
+		{
+			name:  "legacy timestamp token",
+			token: base64.StdEncoding.EncodeToString([]byte("1730000000")),
+			want:  Cursor{UnixTs: 1730000000},
+		},
+		{
+			name:  "token with an ordering",
+			token: base64.StdEncoding.EncodeToString([]byte(`{"ts":1730000000,"id":"actor1","order":"first_liked"}`)),
+			want:  Cursor{UnixTs: 1730000000, ID: "actor1", Order: "first_liked"},
+		},
+		{
+			name:    "invalid base64",
+			token:   "not base64!",
+			wantErr: true,
+		},
+		{
+			name:    "garbage payload",
+			token:   base64.StdEncoding.EncodeToString([]byte("garbage")),
+			wantErr: true,
+		},
+		{
+			name:    "missing actor id",
+			token:   base64.StdEncoding.EncodeToString([]byte(`{"ts":1730000000}`)),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCursor_Less(t *testing.T) {
+	tests := []struct {
+		a, b Cursor
+		want bool
+	}{
+		{Cursor{}, Cursor{UnixTs: 1, ID: "a"}, true},
+		{Cursor{UnixTs: 1, ID: "b"}, Cursor{UnixTs: 2, ID: "a"}, true},
+		{Cursor{UnixTs: 2, ID: "a"}, Cursor{UnixTs: 2, ID: "b"}, true},
+		{Cursor{UnixTs: 2, ID: "b"}, Cursor{UnixTs: 2, ID: "b"}, false},
+		{Cursor{UnixTs: 3, ID: "a"}, Cursor{UnixTs: 2, ID: "b"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Less(tt.b); got != tt.want {
+			t.Errorf("%+v.Less(%+v): expected %v, got %v", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
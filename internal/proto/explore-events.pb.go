@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: explore/explore-events.proto
+
+package explore
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DecisionRecorded is published for every like and pass, including repeated
+// ones and decisions between users who blocked each other.
+type DecisionRecorded struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventId         string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ActorUserId     string                 `protobuf:"bytes,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,3,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	LikedRecipient  bool                   `protobuf:"varint,4,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	UnixTimestamp   uint64                 `protobuf:"varint,5,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DecisionRecorded) Reset() {
+	*x = DecisionRecorded{}
+	mi := &file_explore_explore_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecisionRecorded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionRecorded) ProtoMessage() {}
+
+func (x *DecisionRecorded) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionRecorded.ProtoReflect.Descriptor instead.
+func (*DecisionRecorded) Descriptor() ([]byte, []int) {
+	return file_explore_explore_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DecisionRecorded) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DecisionRecorded) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *DecisionRecorded) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *DecisionRecorded) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+func (x *DecisionRecorded) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+// MatchCreated is published once per match, when the second like lands.
+type MatchCreated struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	EventId              string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MatchId              string                 `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	ActorUserId          string                 `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"` // The user whose like created the match
+	RecipientUserId      string                 `protobuf:"bytes,4,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	MatchedUnixTimestamp uint64                 `protobuf:"varint,5,opt,name=matched_unix_timestamp,json=matchedUnixTimestamp,proto3" json:"matched_unix_timestamp,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MatchCreated) Reset() {
+	*x = MatchCreated{}
+	mi := &file_explore_explore_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchCreated) ProtoMessage() {}
+
+func (x *MatchCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchCreated.ProtoReflect.Descriptor instead.
+func (*MatchCreated) Descriptor() ([]byte, []int) {
+	return file_explore_explore_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatchCreated) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetMatchedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.MatchedUnixTimestamp
+	}
+	return 0
+}
+
+var File_explore_explore_events_proto protoreflect.FileDescriptor
+
+const file_explore_explore_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1cexplore/explore-events.proto\x12\aexplore\"\xcd\x01\n" +
+	"\x10DecisionRecorded\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x03 \x01(\tR\x0frecipientUserId\x12'\n" +
+	"\x0fliked_recipient\x18\x04 \x01(\bR\x0elikedRecipient\x12%\n" +
+	"\x0eunix_timestamp\x18\x05 \x01(\x04R\runixTimestamp\"\xca\x01\n" +
+	"\fMatchCreated\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12\"\n" +
+	"\ractor_user_id\x18\x03 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x04 \x01(\tR\x0frecipientUserId\x124\n" +
+	"\x16matched_unix_timestamp\x18\x05 \x01(\x04R\x14matchedUnixTimestampB:Z8github.com/jacob-alt-del/explore-service/explore;exploreb\x06proto3"
+
+var (
+	file_explore_explore_events_proto_rawDescOnce sync.Once
+	file_explore_explore_events_proto_rawDescData []byte
+)
+
+func file_explore_explore_events_proto_rawDescGZIP() []byte {
+	file_explore_explore_events_proto_rawDescOnce.Do(func() {
+		file_explore_explore_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_explore_explore_events_proto_rawDesc), len(file_explore_explore_events_proto_rawDesc)))
+	})
+	return file_explore_explore_events_proto_rawDescData
+}
+
+var file_explore_explore_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_explore_explore_events_proto_goTypes = []any{
+	(*DecisionRecorded)(nil), // 0: explore.DecisionRecorded
+	(*MatchCreated)(nil),     // 1: explore.MatchCreated
+}
+var file_explore_explore_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_explore_explore_events_proto_init() }
+func file_explore_explore_events_proto_init() {
+	if File_explore_explore_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_explore_explore_events_proto_rawDesc), len(file_explore_explore_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_explore_explore_events_proto_goTypes,
+		DependencyIndexes: file_explore_explore_events_proto_depIdxs,
+		MessageInfos:      file_explore_explore_events_proto_msgTypes,
+	}.Build()
+	File_explore_explore_events_proto = out.File
+	file_explore_explore_events_proto_goTypes = nil
+	file_explore_explore_events_proto_depIdxs = nil
+}
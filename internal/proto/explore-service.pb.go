@@ -21,13 +21,218 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LikedYouOrder int32
+
+const (
+	LikedYouOrder_LIKED_YOU_ORDER_FIRST_LIKED     LikedYouOrder = 0 // Newest first by when the actor first decided on the recipient, passing and liking again doesn't move them up
+	LikedYouOrder_LIKED_YOU_ORDER_RECENT_ACTIVITY LikedYouOrder = 1 // Newest first by the actor's latest decision
+	LikedYouOrder_LIKED_YOU_ORDER_OLDEST_FIRST    LikedYouOrder = 2 // Oldest first by when the actor first decided on the recipient
+)
+
+// Enum value maps for LikedYouOrder.
+var (
+	LikedYouOrder_name = map[int32]string{
+		0: "LIKED_YOU_ORDER_FIRST_LIKED",
+		1: "LIKED_YOU_ORDER_RECENT_ACTIVITY",
+		2: "LIKED_YOU_ORDER_OLDEST_FIRST",
+	}
+	LikedYouOrder_value = map[string]int32{
+		"LIKED_YOU_ORDER_FIRST_LIKED":     0,
+		"LIKED_YOU_ORDER_RECENT_ACTIVITY": 1,
+		"LIKED_YOU_ORDER_OLDEST_FIRST":    2,
+	}
+)
+
+func (x LikedYouOrder) Enum() *LikedYouOrder {
+	p := new(LikedYouOrder)
+	*p = x
+	return p
+}
+
+func (x LikedYouOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LikedYouOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_explore_explore_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LikedYouOrder) Type() protoreflect.EnumType {
+	return &file_explore_explore_service_proto_enumTypes[0]
+}
+
+func (x LikedYouOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LikedYouOrder.Descriptor instead.
+func (LikedYouOrder) EnumDescriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{0}
+}
+
+type LikedYouEventType int32
+
+const (
+	LikedYouEventType_LIKED_YOU_EVENT_TYPE_UNSPECIFIED LikedYouEventType = 0
+	LikedYouEventType_LIKED_YOU_EVENT_TYPE_LIKE        LikedYouEventType = 1 // actor_user_id liked the recipient
+	LikedYouEventType_LIKED_YOU_EVENT_TYPE_MATCH       LikedYouEventType = 2 // The recipient and actor_user_id matched
+	LikedYouEventType_LIKED_YOU_EVENT_TYPE_RESYNC      LikedYouEventType = 3 // Events were missed and can't be replayed, refetch the liked you list and count
+)
+
+// Enum value maps for LikedYouEventType.
+var (
+	LikedYouEventType_name = map[int32]string{
+		0: "LIKED_YOU_EVENT_TYPE_UNSPECIFIED",
+		1: "LIKED_YOU_EVENT_TYPE_LIKE",
+		2: "LIKED_YOU_EVENT_TYPE_MATCH",
+		3: "LIKED_YOU_EVENT_TYPE_RESYNC",
+	}
+	LikedYouEventType_value = map[string]int32{
+		"LIKED_YOU_EVENT_TYPE_UNSPECIFIED": 0,
+		"LIKED_YOU_EVENT_TYPE_LIKE":        1,
+		"LIKED_YOU_EVENT_TYPE_MATCH":       2,
+		"LIKED_YOU_EVENT_TYPE_RESYNC":      3,
+	}
+)
+
+func (x LikedYouEventType) Enum() *LikedYouEventType {
+	p := new(LikedYouEventType)
+	*p = x
+	return p
+}
+
+func (x LikedYouEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LikedYouEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_explore_explore_service_proto_enumTypes[1].Descriptor()
+}
+
+func (LikedYouEventType) Type() protoreflect.EnumType {
+	return &file_explore_explore_service_proto_enumTypes[1]
+}
+
+func (x LikedYouEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LikedYouEventType.Descriptor instead.
+func (LikedYouEventType) EnumDescriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{1}
+}
+
+type YouLikedFilter int32
+
+const (
+	YouLikedFilter_YOU_LIKED_FILTER_ALL     YouLikedFilter = 0
+	YouLikedFilter_YOU_LIKED_FILTER_PENDING YouLikedFilter = 1 // Likes that have not turned into a match
+	YouLikedFilter_YOU_LIKED_FILTER_MATCHED YouLikedFilter = 2 // Likes with an active match
+)
+
+// Enum value maps for YouLikedFilter.
+var (
+	YouLikedFilter_name = map[int32]string{
+		0: "YOU_LIKED_FILTER_ALL",
+		1: "YOU_LIKED_FILTER_PENDING",
+		2: "YOU_LIKED_FILTER_MATCHED",
+	}
+	YouLikedFilter_value = map[string]int32{
+		"YOU_LIKED_FILTER_ALL":     0,
+		"YOU_LIKED_FILTER_PENDING": 1,
+		"YOU_LIKED_FILTER_MATCHED": 2,
+	}
+)
+
+func (x YouLikedFilter) Enum() *YouLikedFilter {
+	p := new(YouLikedFilter)
+	*p = x
+	return p
+}
+
+func (x YouLikedFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (YouLikedFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_explore_explore_service_proto_enumTypes[2].Descriptor()
+}
+
+func (YouLikedFilter) Type() protoreflect.EnumType {
+	return &file_explore_explore_service_proto_enumTypes[2]
+}
+
+func (x YouLikedFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use YouLikedFilter.Descriptor instead.
+func (YouLikedFilter) EnumDescriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{2}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1 // Not delivered yet, attempted again at next_attempt_unix_timestamp
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED   WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD        WebhookDeliveryStatus = 3 // Gave up after the maximum number of attempts
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_explore_explore_service_proto_enumTypes[3].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_explore_explore_service_proto_enumTypes[3]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{3}
+}
+
 type ListLikedYouRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RecipientUserId string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
-	PaginationToken *string                `protobuf:"bytes,2,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
-	PageSize        *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // Amount of items wanted in a single page
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RecipientUserId    string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	PaginationToken    *string                `protobuf:"bytes,2,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
+	PageSize           *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`                                 // Amount of items wanted in a single page
+	Order              *LikedYouOrder         `protobuf:"varint,4,opt,name=order,proto3,enum=explore.LikedYouOrder,oneof" json:"order,omitempty"`                            // Defaults to the order of pagination_token, FIRST_LIKED on the first page. A token can't be reused with another order
+	SinceUnixTimestamp *uint64                `protobuf:"varint,5,opt,name=since_unix_timestamp,json=sinceUnixTimestamp,proto3,oneof" json:"since_unix_timestamp,omitempty"` // Only likers whose latest like is at or after this time, in every order. A FIRST_LIKED or OLDEST_FIRST page can hold likers who first liked earlier
+	UntilUnixTimestamp *uint64                `protobuf:"varint,6,opt,name=until_unix_timestamp,json=untilUnixTimestamp,proto3,oneof" json:"until_unix_timestamp,omitempty"` // Only likers whose latest like is before this time, in every order
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ListLikedYouRequest) Reset() {
@@ -81,10 +286,32 @@ func (x *ListLikedYouRequest) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *ListLikedYouRequest) GetOrder() LikedYouOrder {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return LikedYouOrder_LIKED_YOU_ORDER_FIRST_LIKED
+}
+
+func (x *ListLikedYouRequest) GetSinceUnixTimestamp() uint64 {
+	if x != nil && x.SinceUnixTimestamp != nil {
+		return *x.SinceUnixTimestamp
+	}
+	return 0
+}
+
+func (x *ListLikedYouRequest) GetUntilUnixTimestamp() uint64 {
+	if x != nil && x.UntilUnixTimestamp != nil {
+		return *x.UntilUnixTimestamp
+	}
+	return 0
+}
+
 type ListLikedYouResponse struct {
 	state               protoimpl.MessageState        `protogen:"open.v1"`
 	Likers              []*ListLikedYouResponse_Liker `protobuf:"bytes,1,rep,name=likers,proto3" json:"likers,omitempty"`
 	NextPaginationToken *string                       `protobuf:"bytes,2,opt,name=next_pagination_token,json=nextPaginationToken,proto3,oneof" json:"next_pagination_token,omitempty"`
+	SeenToken           *string                       `protobuf:"bytes,3,opt,name=seen_token,json=seenToken,proto3,oneof" json:"seen_token,omitempty"` // Pass to MarkLikesSeen to mark the likers on this page and every older like as seen, unset for an empty page
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -133,11 +360,20 @@ func (x *ListLikedYouResponse) GetNextPaginationToken() string {
 	return ""
 }
 
+func (x *ListLikedYouResponse) GetSeenToken() string {
+	if x != nil && x.SeenToken != nil {
+		return *x.SeenToken
+	}
+	return ""
+}
+
 type CountLikedYouRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RecipientUserId string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RecipientUserId    string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	SinceUnixTimestamp *uint64                `protobuf:"varint,2,opt,name=since_unix_timestamp,json=sinceUnixTimestamp,proto3,oneof" json:"since_unix_timestamp,omitempty"` // Only likers whose latest like is at or after this time, not supported by CountUnseenLikedYou
+	UntilUnixTimestamp *uint64                `protobuf:"varint,3,opt,name=until_unix_timestamp,json=untilUnixTimestamp,proto3,oneof" json:"until_unix_timestamp,omitempty"` // Only likers whose latest like is before this time, not supported by CountUnseenLikedYou
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CountLikedYouRequest) Reset() {
@@ -177,6 +413,20 @@ func (x *CountLikedYouRequest) GetRecipientUserId() string {
 	return ""
 }
 
+func (x *CountLikedYouRequest) GetSinceUnixTimestamp() uint64 {
+	if x != nil && x.SinceUnixTimestamp != nil {
+		return *x.SinceUnixTimestamp
+	}
+	return 0
+}
+
+func (x *CountLikedYouRequest) GetUntilUnixTimestamp() uint64 {
+	if x != nil && x.UntilUnixTimestamp != nil {
+		return *x.UntilUnixTimestamp
+	}
+	return 0
+}
+
 type CountLikedYouResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Count         uint64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -221,29 +471,28 @@ func (x *CountLikedYouResponse) GetCount() uint64 {
 	return 0
 }
 
-type PutDecisionRequest struct {
+type WatchLikedYouRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId     string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
-	RecipientUserId string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
-	LikedRecipient  bool                   `protobuf:"varint,3,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	ResumeToken     *string                `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"` // The resume_token of the last event received, replays the events missed since when still possible
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PutDecisionRequest) Reset() {
-	*x = PutDecisionRequest{}
+func (x *WatchLikedYouRequest) Reset() {
+	*x = WatchLikedYouRequest{}
 	mi := &file_explore_explore_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PutDecisionRequest) String() string {
+func (x *WatchLikedYouRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutDecisionRequest) ProtoMessage() {}
+func (*WatchLikedYouRequest) ProtoMessage() {}
 
-func (x *PutDecisionRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchLikedYouRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_explore_explore_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -255,53 +504,50 @@ func (x *PutDecisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutDecisionRequest.ProtoReflect.Descriptor instead.
-func (*PutDecisionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchLikedYouRequest.ProtoReflect.Descriptor instead.
+func (*WatchLikedYouRequest) Descriptor() ([]byte, []int) {
 	return file_explore_explore_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PutDecisionRequest) GetActorUserId() string {
-	if x != nil {
-		return x.ActorUserId
-	}
-	return ""
-}
-
-func (x *PutDecisionRequest) GetRecipientUserId() string {
+func (x *WatchLikedYouRequest) GetRecipientUserId() string {
 	if x != nil {
 		return x.RecipientUserId
 	}
 	return ""
 }
 
-func (x *PutDecisionRequest) GetLikedRecipient() bool {
-	if x != nil {
-		return x.LikedRecipient
+func (x *WatchLikedYouRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
 	}
-	return false
+	return ""
 }
 
-type PutDecisionResponse struct {
+type LikedYouEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MutualLikes   bool                   `protobuf:"varint,1,opt,name=mutual_likes,json=mutualLikes,proto3" json:"mutual_likes,omitempty"` // True if both users like each other
+	Type          LikedYouEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=explore.LikedYouEventType" json:"type,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"` // Unset for RESYNC
+	MatchId       *string                `protobuf:"bytes,3,opt,name=match_id,json=matchId,proto3,oneof" json:"match_id,omitempty"`         // Set for MATCH
+	UnixTimestamp uint64                 `protobuf:"varint,4,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Pass to WatchLikedYou when reconnecting to continue after this event
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PutDecisionResponse) Reset() {
-	*x = PutDecisionResponse{}
+func (x *LikedYouEvent) Reset() {
+	*x = LikedYouEvent{}
 	mi := &file_explore_explore_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PutDecisionResponse) String() string {
+func (x *LikedYouEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutDecisionResponse) ProtoMessage() {}
+func (*LikedYouEvent) ProtoMessage() {}
 
-func (x *PutDecisionResponse) ProtoReflect() protoreflect.Message {
+func (x *LikedYouEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_explore_explore_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,40 +559,68 @@ func (x *PutDecisionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutDecisionResponse.ProtoReflect.Descriptor instead.
-func (*PutDecisionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LikedYouEvent.ProtoReflect.Descriptor instead.
+func (*LikedYouEvent) Descriptor() ([]byte, []int) {
 	return file_explore_explore_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PutDecisionResponse) GetMutualLikes() bool {
+func (x *LikedYouEvent) GetType() LikedYouEventType {
 	if x != nil {
-		return x.MutualLikes
+		return x.Type
 	}
-	return false
+	return LikedYouEventType_LIKED_YOU_EVENT_TYPE_UNSPECIFIED
 }
 
-type ListLikedYouResponse_Liker struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	UnixTimestamp uint64                 `protobuf:"varint,2,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LikedYouEvent) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
 }
 
-func (x *ListLikedYouResponse_Liker) Reset() {
-	*x = ListLikedYouResponse_Liker{}
+func (x *LikedYouEvent) GetMatchId() string {
+	if x != nil && x.MatchId != nil {
+		return *x.MatchId
+	}
+	return ""
+}
+
+func (x *LikedYouEvent) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+func (x *LikedYouEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type MarkLikesSeenRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecipientUserId string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	UpToToken       string                 `protobuf:"bytes,2,opt,name=up_to_token,json=upToToken,proto3" json:"up_to_token,omitempty"` // A seen_token from ListLikedYouResponse, the watermark never moves back
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkLikesSeenRequest) Reset() {
+	*x = MarkLikesSeenRequest{}
 	mi := &file_explore_explore_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLikedYouResponse_Liker) String() string {
+func (x *MarkLikesSeenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLikedYouResponse_Liker) ProtoMessage() {}
+func (*MarkLikesSeenRequest) ProtoMessage() {}
 
-func (x *ListLikedYouResponse_Liker) ProtoReflect() protoreflect.Message {
+func (x *MarkLikesSeenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_explore_explore_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -358,59 +632,3479 @@ func (x *ListLikedYouResponse_Liker) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLikedYouResponse_Liker.ProtoReflect.Descriptor instead.
-func (*ListLikedYouResponse_Liker) Descriptor() ([]byte, []int) {
-	return file_explore_explore_service_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use MarkLikesSeenRequest.ProtoReflect.Descriptor instead.
+func (*MarkLikesSeenRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListLikedYouResponse_Liker) GetActorId() string {
+func (x *MarkLikesSeenRequest) GetRecipientUserId() string {
 	if x != nil {
-		return x.ActorId
+		return x.RecipientUserId
 	}
 	return ""
 }
 
-func (x *ListLikedYouResponse_Liker) GetUnixTimestamp() uint64 {
+func (x *MarkLikesSeenRequest) GetUpToToken() string {
 	if x != nil {
-		return x.UnixTimestamp
+		return x.UpToToken
 	}
-	return 0
+	return ""
 }
 
-var File_explore_explore_service_proto protoreflect.FileDescriptor
+type MarkLikesSeenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_explore_explore_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1dexplore/explore-service.proto\x12\aexplore\"\xb6\x01\n" +
-	"\x13ListLikedYouRequest\x12*\n" +
-	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x12.\n" +
-	"\x10pagination_token\x18\x02 \x01(\tH\x00R\x0fpaginationToken\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01B\x13\n" +
-	"\x11_pagination_tokenB\f\n" +
-	"\n" +
-	"_page_size\"\xf1\x01\n" +
-	"\x14ListLikedYouResponse\x12;\n" +
-	"\x06likers\x18\x01 \x03(\v2#.explore.ListLikedYouResponse.LikerR\x06likers\x127\n" +
-	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01\x1aI\n" +
-	"\x05Liker\x12\x19\n" +
-	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12%\n" +
-	"\x0eunix_timestamp\x18\x02 \x01(\x04R\runixTimestampB\x18\n" +
-	"\x16_next_pagination_token\"B\n" +
-	"\x14CountLikedYouRequest\x12*\n" +
-	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\"-\n" +
-	"\x15CountLikedYouResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x04R\x05count\"\x8d\x01\n" +
-	"\x12PutDecisionRequest\x12\"\n" +
-	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12*\n" +
-	"\x11recipient_user_id\x18\x02 \x01(\tR\x0frecipientUserId\x12'\n" +
-	"\x0fliked_recipient\x18\x03 \x01(\bR\x0elikedRecipient\"8\n" +
-	"\x13PutDecisionResponse\x12!\n" +
-	"\fmutual_likes\x18\x01 \x01(\bR\vmutualLikes2\xc7\x02\n" +
-	"\x0eExploreService\x12K\n" +
-	"\fListLikedYou\x12\x1c.explore.ListLikedYouRequest\x1a\x1d.explore.ListLikedYouResponse\x12N\n" +
-	"\x0fListNewLikedYou\x12\x1c.explore.ListLikedYouRequest\x1a\x1d.explore.ListLikedYouResponse\x12N\n" +
-	"\rCountLikedYou\x12\x1d.explore.CountLikedYouRequest\x1a\x1e.explore.CountLikedYouResponse\x12H\n" +
-	"\vPutDecision\x12\x1b.explore.PutDecisionRequest\x1a\x1c.explore.PutDecisionResponseB:Z8github.com/jacob-alt-del/explore-service/explore;exploreb\x06proto3"
+func (x *MarkLikesSeenResponse) Reset() {
+	*x = MarkLikesSeenResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkLikesSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkLikesSeenResponse) ProtoMessage() {}
+
+func (x *MarkLikesSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkLikesSeenResponse.ProtoReflect.Descriptor instead.
+func (*MarkLikesSeenResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ListYouLikedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId     string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	PaginationToken *string                `protobuf:"bytes,2,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
+	PageSize        *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // Amount of items wanted in a single page
+	Filter          YouLikedFilter         `protobuf:"varint,4,opt,name=filter,proto3,enum=explore.YouLikedFilter" json:"filter,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListYouLikedRequest) Reset() {
+	*x = ListYouLikedRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListYouLikedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListYouLikedRequest) ProtoMessage() {}
+
+func (x *ListYouLikedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListYouLikedRequest.ProtoReflect.Descriptor instead.
+func (*ListYouLikedRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListYouLikedRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListYouLikedRequest) GetPaginationToken() string {
+	if x != nil && x.PaginationToken != nil {
+		return *x.PaginationToken
+	}
+	return ""
+}
+
+func (x *ListYouLikedRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListYouLikedRequest) GetFilter() YouLikedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return YouLikedFilter_YOU_LIKED_FILTER_ALL
+}
+
+type ListYouLikedResponse struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	Likees              []*ListYouLikedResponse_Likee `protobuf:"bytes,1,rep,name=likees,proto3" json:"likees,omitempty"`
+	NextPaginationToken *string                       `protobuf:"bytes,2,opt,name=next_pagination_token,json=nextPaginationToken,proto3,oneof" json:"next_pagination_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListYouLikedResponse) Reset() {
+	*x = ListYouLikedResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListYouLikedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListYouLikedResponse) ProtoMessage() {}
+
+func (x *ListYouLikedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListYouLikedResponse.ProtoReflect.Descriptor instead.
+func (*ListYouLikedResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListYouLikedResponse) GetLikees() []*ListYouLikedResponse_Likee {
+	if x != nil {
+		return x.Likees
+	}
+	return nil
+}
+
+func (x *ListYouLikedResponse) GetNextPaginationToken() string {
+	if x != nil && x.NextPaginationToken != nil {
+		return *x.NextPaginationToken
+	}
+	return ""
+}
+
+type CountYouLikedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Filter        YouLikedFilter         `protobuf:"varint,2,opt,name=filter,proto3,enum=explore.YouLikedFilter" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountYouLikedRequest) Reset() {
+	*x = CountYouLikedRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountYouLikedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountYouLikedRequest) ProtoMessage() {}
+
+func (x *CountYouLikedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountYouLikedRequest.ProtoReflect.Descriptor instead.
+func (*CountYouLikedRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CountYouLikedRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *CountYouLikedRequest) GetFilter() YouLikedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return YouLikedFilter_YOU_LIKED_FILTER_ALL
+}
+
+type CountYouLikedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         uint64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountYouLikedResponse) Reset() {
+	*x = CountYouLikedResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountYouLikedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountYouLikedResponse) ProtoMessage() {}
+
+func (x *CountYouLikedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountYouLikedResponse.ProtoReflect.Descriptor instead.
+func (*CountYouLikedResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CountYouLikedResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FilterUndecidedRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId       string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	CandidateUserIds  []string               `protobuf:"bytes,2,rep,name=candidate_user_ids,json=candidateUserIds,proto3" json:"candidate_user_ids,omitempty"`
+	PassExpirySeconds *uint64                `protobuf:"varint,3,opt,name=pass_expiry_seconds,json=passExpirySeconds,proto3,oneof" json:"pass_expiry_seconds,omitempty"` // Passes older than this count as undecided, likes never do
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FilterUndecidedRequest) Reset() {
+	*x = FilterUndecidedRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterUndecidedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterUndecidedRequest) ProtoMessage() {}
+
+func (x *FilterUndecidedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterUndecidedRequest.ProtoReflect.Descriptor instead.
+func (*FilterUndecidedRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FilterUndecidedRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *FilterUndecidedRequest) GetCandidateUserIds() []string {
+	if x != nil {
+		return x.CandidateUserIds
+	}
+	return nil
+}
+
+func (x *FilterUndecidedRequest) GetPassExpirySeconds() uint64 {
+	if x != nil && x.PassExpirySeconds != nil {
+		return *x.PassExpirySeconds
+	}
+	return 0
+}
+
+type FilterUndecidedResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CandidateUserIds []string               `protobuf:"bytes,1,rep,name=candidate_user_ids,json=candidateUserIds,proto3" json:"candidate_user_ids,omitempty"` // Undecided candidates in request order, without duplicates
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FilterUndecidedResponse) Reset() {
+	*x = FilterUndecidedResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterUndecidedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterUndecidedResponse) ProtoMessage() {}
+
+func (x *FilterUndecidedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterUndecidedResponse.ProtoReflect.Descriptor instead.
+func (*FilterUndecidedResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FilterUndecidedResponse) GetCandidateUserIds() []string {
+	if x != nil {
+		return x.CandidateUserIds
+	}
+	return nil
+}
+
+type GetDecisionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId     string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetDecisionRequest) Reset() {
+	*x = GetDecisionRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDecisionRequest) ProtoMessage() {}
+
+func (x *GetDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDecisionRequest.ProtoReflect.Descriptor instead.
+func (*GetDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetDecisionRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *GetDecisionRequest) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+type PairDecisions struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	ActorUserId       string                  `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId   string                  `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	ActorDecision     *PairDecisions_Decision `protobuf:"bytes,3,opt,name=actor_decision,json=actorDecision,proto3" json:"actor_decision,omitempty"`             // Unset if the actor has not decided on the recipient
+	RecipientDecision *PairDecisions_Decision `protobuf:"bytes,4,opt,name=recipient_decision,json=recipientDecision,proto3" json:"recipient_decision,omitempty"` // Unset if the recipient has not decided on the actor, or is hidden from the actor by a block, unmatch or deactivation
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PairDecisions) Reset() {
+	*x = PairDecisions{}
+	mi := &file_explore_explore_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairDecisions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairDecisions) ProtoMessage() {}
+
+func (x *PairDecisions) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairDecisions.ProtoReflect.Descriptor instead.
+func (*PairDecisions) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PairDecisions) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *PairDecisions) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *PairDecisions) GetActorDecision() *PairDecisions_Decision {
+	if x != nil {
+		return x.ActorDecision
+	}
+	return nil
+}
+
+func (x *PairDecisions) GetRecipientDecision() *PairDecisions_Decision {
+	if x != nil {
+		return x.RecipientDecision
+	}
+	return nil
+}
+
+type BatchGetDecisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*GetDecisionRequest  `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetDecisionsRequest) Reset() {
+	*x = BatchGetDecisionsRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDecisionsRequest) ProtoMessage() {}
+
+func (x *BatchGetDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchGetDecisionsRequest) GetPairs() []*GetDecisionRequest {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type BatchGetDecisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*PairDecisions       `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // In request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetDecisionsResponse) Reset() {
+	*x = BatchGetDecisionsResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDecisionsResponse) ProtoMessage() {}
+
+func (x *BatchGetDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchGetDecisionsResponse) GetPairs() []*PairDecisions {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type PutDecisionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId     string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	LikedRecipient  bool                   `protobuf:"varint,3,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutDecisionRequest) Reset() {
+	*x = PutDecisionRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDecisionRequest) ProtoMessage() {}
+
+func (x *PutDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDecisionRequest.ProtoReflect.Descriptor instead.
+func (*PutDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PutDecisionRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *PutDecisionRequest) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *PutDecisionRequest) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+type PutDecisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutualLikes   bool                   `protobuf:"varint,1,opt,name=mutual_likes,json=mutualLikes,proto3" json:"mutual_likes,omitempty"` // True if both users like each other
+	Match         *Match                 `protobuf:"bytes,2,opt,name=match,proto3,oneof" json:"match,omitempty"`                           // Set when this decision created a new match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDecisionResponse) Reset() {
+	*x = PutDecisionResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDecisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDecisionResponse) ProtoMessage() {}
+
+func (x *PutDecisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDecisionResponse.ProtoReflect.Descriptor instead.
+func (*PutDecisionResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PutDecisionResponse) GetMutualLikes() bool {
+	if x != nil {
+		return x.MutualLikes
+	}
+	return false
+}
+
+func (x *PutDecisionResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type PutDecisionsRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	ActorUserId   string                          `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Decisions     []*PutDecisionsRequest_Decision `protobuf:"bytes,2,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDecisionsRequest) Reset() {
+	*x = PutDecisionsRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDecisionsRequest) ProtoMessage() {}
+
+func (x *PutDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*PutDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PutDecisionsRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *PutDecisionsRequest) GetDecisions() []*PutDecisionsRequest_Decision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+type PutDecisionsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Results       []*PutDecisionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One result per decision, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDecisionsResponse) Reset() {
+	*x = PutDecisionsResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDecisionsResponse) ProtoMessage() {}
+
+func (x *PutDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*PutDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PutDecisionsResponse) GetResults() []*PutDecisionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetLikeQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikeQuotaRequest) Reset() {
+	*x = GetLikeQuotaRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeQuotaRequest) ProtoMessage() {}
+
+func (x *GetLikeQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetLikeQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLikeQuotaRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+type LikeQuota struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Tier               string                 `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`                                                                // Entitlement tier of the actor, e.g. free or premium
+	Unlimited          bool                   `protobuf:"varint,2,opt,name=unlimited,proto3" json:"unlimited,omitempty"`                                                     // True if the tier has no daily like quota, the counts below are then unset
+	DailyLimit         *uint32                `protobuf:"varint,3,opt,name=daily_limit,json=dailyLimit,proto3,oneof" json:"daily_limit,omitempty"`                           // How many users can be liked within a rolling day
+	Remaining          *uint32                `protobuf:"varint,4,opt,name=remaining,proto3,oneof" json:"remaining,omitempty"`                                               // How many more users can be liked now
+	ResetUnixTimestamp *uint64                `protobuf:"varint,5,opt,name=reset_unix_timestamp,json=resetUnixTimestamp,proto3,oneof" json:"reset_unix_timestamp,omitempty"` // When the oldest like counted leaves the rolling day, freeing up a like. Unset when none are counted
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LikeQuota) Reset() {
+	*x = LikeQuota{}
+	mi := &file_explore_explore_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeQuota) ProtoMessage() {}
+
+func (x *LikeQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeQuota.ProtoReflect.Descriptor instead.
+func (*LikeQuota) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LikeQuota) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *LikeQuota) GetUnlimited() bool {
+	if x != nil {
+		return x.Unlimited
+	}
+	return false
+}
+
+func (x *LikeQuota) GetDailyLimit() uint32 {
+	if x != nil && x.DailyLimit != nil {
+		return *x.DailyLimit
+	}
+	return 0
+}
+
+func (x *LikeQuota) GetRemaining() uint32 {
+	if x != nil && x.Remaining != nil {
+		return *x.Remaining
+	}
+	return 0
+}
+
+func (x *LikeQuota) GetResetUnixTimestamp() uint64 {
+	if x != nil && x.ResetUnixTimestamp != nil {
+		return *x.ResetUnixTimestamp
+	}
+	return 0
+}
+
+type Match struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MatchId              string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The other user in the match
+	MatchedUnixTimestamp uint64                 `protobuf:"varint,3,opt,name=matched_unix_timestamp,json=matchedUnixTimestamp,proto3" json:"matched_unix_timestamp,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_explore_explore_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Match) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *Match) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Match) GetMatchedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.MatchedUnixTimestamp
+	}
+	return 0
+}
+
+type ListDecisionHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId     string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	PaginationToken *string                `protobuf:"bytes,3,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
+	PageSize        *uint32                `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListDecisionHistoryRequest) Reset() {
+	*x = ListDecisionHistoryRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDecisionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecisionHistoryRequest) ProtoMessage() {}
+
+func (x *ListDecisionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecisionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListDecisionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDecisionHistoryRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListDecisionHistoryRequest) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *ListDecisionHistoryRequest) GetPaginationToken() string {
+	if x != nil && x.PaginationToken != nil {
+		return *x.PaginationToken
+	}
+	return ""
+}
+
+func (x *ListDecisionHistoryRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListDecisionHistoryResponse struct {
+	state               protoimpl.MessageState               `protogen:"open.v1"`
+	Events              []*ListDecisionHistoryResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPaginationToken *string                              `protobuf:"bytes,2,opt,name=next_pagination_token,json=nextPaginationToken,proto3,oneof" json:"next_pagination_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListDecisionHistoryResponse) Reset() {
+	*x = ListDecisionHistoryResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDecisionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecisionHistoryResponse) ProtoMessage() {}
+
+func (x *ListDecisionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecisionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListDecisionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDecisionHistoryResponse) GetEvents() []*ListDecisionHistoryResponse_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListDecisionHistoryResponse) GetNextPaginationToken() string {
+	if x != nil && x.NextPaginationToken != nil {
+		return *x.NextPaginationToken
+	}
+	return ""
+}
+
+type ListMatchesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaginationToken *string                `protobuf:"bytes,2,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
+	PageSize        *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // Amount of items wanted in a single page
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMatchesRequest) Reset() {
+	*x = ListMatchesRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesRequest) ProtoMessage() {}
+
+func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMatchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetPaginationToken() string {
+	if x != nil && x.PaginationToken != nil {
+		return *x.PaginationToken
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListMatchesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Matches             []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	NextPaginationToken *string                `protobuf:"bytes,2,opt,name=next_pagination_token,json=nextPaginationToken,proto3,oneof" json:"next_pagination_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListMatchesResponse) Reset() {
+	*x = ListMatchesResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesResponse) ProtoMessage() {}
+
+func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ListMatchesResponse) GetNextPaginationToken() string {
+	if x != nil && x.NextPaginationToken != nil {
+		return *x.NextPaginationToken
+	}
+	return ""
+}
+
+type UnmatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	OtherUserId   string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmatchRequest) Reset() {
+	*x = UnmatchRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchRequest) ProtoMessage() {}
+
+func (x *UnmatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchRequest.ProtoReflect.Descriptor instead.
+func (*UnmatchRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnmatchRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *UnmatchRequest) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+type UnmatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmatchResponse) Reset() {
+	*x = UnmatchResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchResponse) ProtoMessage() {}
+
+func (x *UnmatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchResponse.ProtoReflect.Descriptor instead.
+func (*UnmatchResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{30}
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	BlockedUserId string                 `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BlockUserRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{32}
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	BlockedUserId string                 `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnblockUserRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *UnblockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{34}
+}
+
+type ListBlockedUsersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaginationToken *string                `protobuf:"bytes,2,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
+	PageSize        *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // Amount of items wanted in a single page
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListBlockedUsersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersRequest) GetPaginationToken() string {
+	if x != nil && x.PaginationToken != nil {
+		return *x.PaginationToken
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListBlockedUsersResponse struct {
+	state               protoimpl.MessageState                  `protogen:"open.v1"`
+	BlockedUsers        []*ListBlockedUsersResponse_BlockedUser `protobuf:"bytes,1,rep,name=blocked_users,json=blockedUsers,proto3" json:"blocked_users,omitempty"`
+	NextPaginationToken *string                                 `protobuf:"bytes,2,opt,name=next_pagination_token,json=nextPaginationToken,proto3,oneof" json:"next_pagination_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListBlockedUsersResponse) GetBlockedUsers() []*ListBlockedUsersResponse_BlockedUser {
+	if x != nil {
+		return x.BlockedUsers
+	}
+	return nil
+}
+
+func (x *ListBlockedUsersResponse) GetNextPaginationToken() string {
+	if x != nil && x.NextPaginationToken != nil {
+		return *x.NextPaginationToken
+	}
+	return ""
+}
+
+type User struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	UserId                   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username                 string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedUnixTimestamp     uint64                 `protobuf:"varint,3,opt,name=created_unix_timestamp,json=createdUnixTimestamp,proto3" json:"created_unix_timestamp,omitempty"`
+	DeactivatedUnixTimestamp *uint64                `protobuf:"varint,4,opt,name=deactivated_unix_timestamp,json=deactivatedUnixTimestamp,proto3,oneof" json:"deactivated_unix_timestamp,omitempty"` // Set while the user is deactivated
+	ErasedUnixTimestamp      *uint64                `protobuf:"varint,5,opt,name=erased_unix_timestamp,json=erasedUnixTimestamp,proto3,oneof" json:"erased_unix_timestamp,omitempty"`                // Set once the user's data has been erased
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_explore_explore_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *User) GetDeactivatedUnixTimestamp() uint64 {
+	if x != nil && x.DeactivatedUnixTimestamp != nil {
+		return *x.DeactivatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *User) GetErasedUnixTimestamp() uint64 {
+	if x != nil && x.ErasedUnixTimestamp != nil {
+		return *x.ErasedUnixTimestamp
+	}
+	return 0
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{41}
+}
+
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserDataExport struct {
+	state                  protoimpl.MessageState          `protogen:"open.v1"`
+	User                   *User                           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	DecisionsMade          []*UserDataExport_Decision      `protobuf:"bytes,2,rep,name=decisions_made,json=decisionsMade,proto3" json:"decisions_made,omitempty"`
+	DecisionsReceived      []*UserDataExport_Decision      `protobuf:"bytes,3,rep,name=decisions_received,json=decisionsReceived,proto3" json:"decisions_received,omitempty"`
+	Matches                []*UserDataExport_Match         `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	Blocks                 []*UserDataExport_Block         `protobuf:"bytes,5,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	ExportedUnixTimestamp  uint64                          `protobuf:"varint,6,opt,name=exported_unix_timestamp,json=exportedUnixTimestamp,proto3" json:"exported_unix_timestamp,omitempty"`
+	DecisionEventsMade     []*UserDataExport_DecisionEvent `protobuf:"bytes,7,rep,name=decision_events_made,json=decisionEventsMade,proto3" json:"decision_events_made,omitempty"`             // Every like and pass the user made, newest first
+	DecisionEventsReceived []*UserDataExport_DecisionEvent `protobuf:"bytes,8,rep,name=decision_events_received,json=decisionEventsReceived,proto3" json:"decision_events_received,omitempty"` // Every like and pass made on the user, newest first
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_explore_explore_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UserDataExport) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetDecisionsMade() []*UserDataExport_Decision {
+	if x != nil {
+		return x.DecisionsMade
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetDecisionsReceived() []*UserDataExport_Decision {
+	if x != nil {
+		return x.DecisionsReceived
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetMatches() []*UserDataExport_Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetBlocks() []*UserDataExport_Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetExportedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.ExportedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *UserDataExport) GetDecisionEventsMade() []*UserDataExport_DecisionEvent {
+	if x != nil {
+		return x.DecisionEventsMade
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetDecisionEventsReceived() []*UserDataExport_DecisionEvent {
+	if x != nil {
+		return x.DecisionEventsReceived
+	}
+	return nil
+}
+
+type EraseUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataRequest) Reset() {
+	*x = EraseUserDataRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataRequest) ProtoMessage() {}
+
+func (x *EraseUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EraseUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type EraseUserDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ErasureId              string                 `protobuf:"bytes,1,opt,name=erasure_id,json=erasureId,proto3" json:"erasure_id,omitempty"` // Id of the audit record for this request
+	UserId                 string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestedUnixTimestamp uint64                 `protobuf:"varint,3,opt,name=requested_unix_timestamp,json=requestedUnixTimestamp,proto3" json:"requested_unix_timestamp,omitempty"`
+	DecisionsDeleted       uint64                 `protobuf:"varint,4,opt,name=decisions_deleted,json=decisionsDeleted,proto3" json:"decisions_deleted,omitempty"`
+	MatchesDeleted         uint64                 `protobuf:"varint,5,opt,name=matches_deleted,json=matchesDeleted,proto3" json:"matches_deleted,omitempty"`
+	BlocksDeleted          uint64                 `protobuf:"varint,6,opt,name=blocks_deleted,json=blocksDeleted,proto3" json:"blocks_deleted,omitempty"`
+	AlreadyErased          bool                   `protobuf:"varint,7,opt,name=already_erased,json=alreadyErased,proto3" json:"already_erased,omitempty"` // True if an earlier request already erased the user
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *EraseUserDataResponse) Reset() {
+	*x = EraseUserDataResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataResponse) ProtoMessage() {}
+
+func (x *EraseUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EraseUserDataResponse) GetErasureId() string {
+	if x != nil {
+		return x.ErasureId
+	}
+	return ""
+}
+
+func (x *EraseUserDataResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EraseUserDataResponse) GetRequestedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.RequestedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetDecisionsDeleted() uint64 {
+	if x != nil {
+		return x.DecisionsDeleted
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetMatchesDeleted() uint64 {
+	if x != nil {
+		return x.MatchesDeleted
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetBlocksDeleted() uint64 {
+	if x != nil {
+		return x.BlocksDeleted
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetAlreadyErased() bool {
+	if x != nil {
+		return x.AlreadyErased
+	}
+	return false
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MatchId         *string                `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3,oneof" json:"match_id,omitempty"`
+	Status          *WebhookDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=explore.WebhookDeliveryStatus,oneof" json:"status,omitempty"`
+	PaginationToken *string                `protobuf:"bytes,3,opt,name=pagination_token,json=paginationToken,proto3,oneof" json:"pagination_token,omitempty"`
+	PageSize        *uint32                `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_explore_explore_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetMatchId() string {
+	if x != nil && x.MatchId != nil {
+		return *x.MatchId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPaginationToken() string {
+	if x != nil && x.PaginationToken != nil {
+		return *x.PaginationToken
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId               uint64                 `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	EventId                  string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MatchId                  string                 `protobuf:"bytes,3,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	EndpointUrl              string                 `protobuf:"bytes,4,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`
+	Status                   WebhookDeliveryStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=explore.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts                 uint32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode           *uint32                `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode,proto3,oneof" json:"last_status_code,omitempty"` // HTTP status of the last attempt, unset if it got no response
+	LastError                *string                `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CreatedUnixTimestamp     uint64                 `protobuf:"varint,9,opt,name=created_unix_timestamp,json=createdUnixTimestamp,proto3" json:"created_unix_timestamp,omitempty"`
+	UpdatedUnixTimestamp     uint64                 `protobuf:"varint,10,opt,name=updated_unix_timestamp,json=updatedUnixTimestamp,proto3" json:"updated_unix_timestamp,omitempty"`
+	NextAttemptUnixTimestamp *uint64                `protobuf:"varint,11,opt,name=next_attempt_unix_timestamp,json=nextAttemptUnixTimestamp,proto3,oneof" json:"next_attempt_unix_timestamp,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_explore_explore_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() uint64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEndpointUrl() string {
+	if x != nil {
+		return x.EndpointUrl
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() uint32 {
+	if x != nil && x.LastStatusCode != nil {
+		return *x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetUpdatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UpdatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptUnixTimestamp() uint64 {
+	if x != nil && x.NextAttemptUnixTimestamp != nil {
+		return *x.NextAttemptUnixTimestamp
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries          []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPaginationToken *string                `protobuf:"bytes,2,opt,name=next_pagination_token,json=nextPaginationToken,proto3,oneof" json:"next_pagination_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_explore_explore_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPaginationToken() string {
+	if x != nil && x.NextPaginationToken != nil {
+		return *x.NextPaginationToken
+	}
+	return ""
+}
+
+type ListLikedYouResponse_Liker struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ActorId              string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UnixTimestamp        uint64                 `protobuf:"varint,2,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	Unseen               bool                   `protobuf:"varint,3,opt,name=unseen,proto3" json:"unseen,omitempty"`                                                           // True if the like is newer than the recipient's seen watermark
+	CreatedUnixTimestamp uint64                 `protobuf:"varint,4,opt,name=created_unix_timestamp,json=createdUnixTimestamp,proto3" json:"created_unix_timestamp,omitempty"` // When the actor first decided on the recipient, unix_timestamp is their latest decision
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListLikedYouResponse_Liker) Reset() {
+	*x = ListLikedYouResponse_Liker{}
+	mi := &file_explore_explore_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikedYouResponse_Liker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikedYouResponse_Liker) ProtoMessage() {}
+
+func (x *ListLikedYouResponse_Liker) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikedYouResponse_Liker.ProtoReflect.Descriptor instead.
+func (*ListLikedYouResponse_Liker) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ListLikedYouResponse_Liker) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListLikedYouResponse_Liker) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+func (x *ListLikedYouResponse_Liker) GetUnseen() bool {
+	if x != nil {
+		return x.Unseen
+	}
+	return false
+}
+
+func (x *ListLikedYouResponse_Liker) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+type ListYouLikedResponse_Likee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId   string                 `protobuf:"bytes,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	UnixTimestamp uint64                 `protobuf:"varint,2,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	Matched       bool                   `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"` // True while the pair has an active match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListYouLikedResponse_Likee) Reset() {
+	*x = ListYouLikedResponse_Likee{}
+	mi := &file_explore_explore_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListYouLikedResponse_Likee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListYouLikedResponse_Likee) ProtoMessage() {}
+
+func (x *ListYouLikedResponse_Likee) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListYouLikedResponse_Likee.ProtoReflect.Descriptor instead.
+func (*ListYouLikedResponse_Likee) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListYouLikedResponse_Likee) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *ListYouLikedResponse_Likee) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+func (x *ListYouLikedResponse_Likee) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+type PairDecisions_Decision struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	LikedRecipient       bool                   `protobuf:"varint,1,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	CreatedUnixTimestamp uint64                 `protobuf:"varint,2,opt,name=created_unix_timestamp,json=createdUnixTimestamp,proto3" json:"created_unix_timestamp,omitempty"` // When the first decision on the pair was made
+	UpdatedUnixTimestamp uint64                 `protobuf:"varint,3,opt,name=updated_unix_timestamp,json=updatedUnixTimestamp,proto3" json:"updated_unix_timestamp,omitempty"` // When the decision was last changed
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PairDecisions_Decision) Reset() {
+	*x = PairDecisions_Decision{}
+	mi := &file_explore_explore_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairDecisions_Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairDecisions_Decision) ProtoMessage() {}
+
+func (x *PairDecisions_Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairDecisions_Decision.ProtoReflect.Descriptor instead.
+func (*PairDecisions_Decision) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *PairDecisions_Decision) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+func (x *PairDecisions_Decision) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *PairDecisions_Decision) GetUpdatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UpdatedUnixTimestamp
+	}
+	return 0
+}
+
+type PutDecisionsRequest_Decision struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecipientUserId string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	LikedRecipient  bool                   `protobuf:"varint,2,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutDecisionsRequest_Decision) Reset() {
+	*x = PutDecisionsRequest_Decision{}
+	mi := &file_explore_explore_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDecisionsRequest_Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDecisionsRequest_Decision) ProtoMessage() {}
+
+func (x *PutDecisionsRequest_Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDecisionsRequest_Decision.ProtoReflect.Descriptor instead.
+func (*PutDecisionsRequest_Decision) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *PutDecisionsRequest_Decision) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *PutDecisionsRequest_Decision) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+type PutDecisionsResponse_Result struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecipientUserId string                 `protobuf:"bytes,1,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	Error           *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`                           // Set when the decision failed validation and was not recorded
+	MutualLikes     bool                   `protobuf:"varint,3,opt,name=mutual_likes,json=mutualLikes,proto3" json:"mutual_likes,omitempty"` // True if both users like each other
+	Match           *Match                 `protobuf:"bytes,4,opt,name=match,proto3,oneof" json:"match,omitempty"`                           // Set when this decision created a new match
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutDecisionsResponse_Result) Reset() {
+	*x = PutDecisionsResponse_Result{}
+	mi := &file_explore_explore_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDecisionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDecisionsResponse_Result) ProtoMessage() {}
+
+func (x *PutDecisionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDecisionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*PutDecisionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *PutDecisionsResponse_Result) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *PutDecisionsResponse_Result) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *PutDecisionsResponse_Result) GetMutualLikes() bool {
+	if x != nil {
+		return x.MutualLikes
+	}
+	return false
+}
+
+func (x *PutDecisionsResponse_Result) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type ListDecisionHistoryResponse_Event struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	LikedRecipient bool                   `protobuf:"varint,2,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	UnixTimestamp  uint64                 `protobuf:"varint,3,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDecisionHistoryResponse_Event) Reset() {
+	*x = ListDecisionHistoryResponse_Event{}
+	mi := &file_explore_explore_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDecisionHistoryResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecisionHistoryResponse_Event) ProtoMessage() {}
+
+func (x *ListDecisionHistoryResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecisionHistoryResponse_Event.ProtoReflect.Descriptor instead.
+func (*ListDecisionHistoryResponse_Event) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ListDecisionHistoryResponse_Event) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ListDecisionHistoryResponse_Event) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+func (x *ListDecisionHistoryResponse_Event) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+type ListBlockedUsersResponse_BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnixTimestamp uint64                 `protobuf:"varint,2,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse_BlockedUser) Reset() {
+	*x = ListBlockedUsersResponse_BlockedUser{}
+	mi := &file_explore_explore_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse_BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse_BlockedUser) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse_BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse_BlockedUser.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse_BlockedUser) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *ListBlockedUsersResponse_BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersResponse_BlockedUser) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+type UserDataExport_Decision struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId          string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId      string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	LikedRecipient       bool                   `protobuf:"varint,3,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	CreatedUnixTimestamp uint64                 `protobuf:"varint,4,opt,name=created_unix_timestamp,json=createdUnixTimestamp,proto3" json:"created_unix_timestamp,omitempty"`
+	UpdatedUnixTimestamp uint64                 `protobuf:"varint,5,opt,name=updated_unix_timestamp,json=updatedUnixTimestamp,proto3" json:"updated_unix_timestamp,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UserDataExport_Decision) Reset() {
+	*x = UserDataExport_Decision{}
+	mi := &file_explore_explore_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport_Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport_Decision) ProtoMessage() {}
+
+func (x *UserDataExport_Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport_Decision.ProtoReflect.Descriptor instead.
+func (*UserDataExport_Decision) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *UserDataExport_Decision) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *UserDataExport_Decision) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *UserDataExport_Decision) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+func (x *UserDataExport_Decision) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *UserDataExport_Decision) GetUpdatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UpdatedUnixTimestamp
+	}
+	return 0
+}
+
+type UserDataExport_Match struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MatchId                string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	UserId                 string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The other user in the match
+	MatchedUnixTimestamp   uint64                 `protobuf:"varint,3,opt,name=matched_unix_timestamp,json=matchedUnixTimestamp,proto3" json:"matched_unix_timestamp,omitempty"`
+	UnmatchedUnixTimestamp *uint64                `protobuf:"varint,4,opt,name=unmatched_unix_timestamp,json=unmatchedUnixTimestamp,proto3,oneof" json:"unmatched_unix_timestamp,omitempty"`
+	UnmatchedByUserId      *string                `protobuf:"bytes,5,opt,name=unmatched_by_user_id,json=unmatchedByUserId,proto3,oneof" json:"unmatched_by_user_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserDataExport_Match) Reset() {
+	*x = UserDataExport_Match{}
+	mi := &file_explore_explore_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport_Match) ProtoMessage() {}
+
+func (x *UserDataExport_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport_Match.ProtoReflect.Descriptor instead.
+func (*UserDataExport_Match) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{45, 1}
+}
+
+func (x *UserDataExport_Match) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *UserDataExport_Match) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDataExport_Match) GetMatchedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.MatchedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *UserDataExport_Match) GetUnmatchedUnixTimestamp() uint64 {
+	if x != nil && x.UnmatchedUnixTimestamp != nil {
+		return *x.UnmatchedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *UserDataExport_Match) GetUnmatchedByUserId() string {
+	if x != nil && x.UnmatchedByUserId != nil {
+		return *x.UnmatchedByUserId
+	}
+	return ""
+}
+
+type UserDataExport_Block struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockedUserId string                 `protobuf:"bytes,1,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	UnixTimestamp uint64                 `protobuf:"varint,2,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport_Block) Reset() {
+	*x = UserDataExport_Block{}
+	mi := &file_explore_explore_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport_Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport_Block) ProtoMessage() {}
+
+func (x *UserDataExport_Block) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport_Block.ProtoReflect.Descriptor instead.
+func (*UserDataExport_Block) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{45, 2}
+}
+
+func (x *UserDataExport_Block) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+func (x *UserDataExport_Block) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+type UserDataExport_DecisionEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventId         uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ActorUserId     string                 `protobuf:"bytes,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,3,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	LikedRecipient  bool                   `protobuf:"varint,4,opt,name=liked_recipient,json=likedRecipient,proto3" json:"liked_recipient,omitempty"`
+	UnixTimestamp   uint64                 `protobuf:"varint,5,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserDataExport_DecisionEvent) Reset() {
+	*x = UserDataExport_DecisionEvent{}
+	mi := &file_explore_explore_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport_DecisionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport_DecisionEvent) ProtoMessage() {}
+
+func (x *UserDataExport_DecisionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_explore_explore_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport_DecisionEvent.ProtoReflect.Descriptor instead.
+func (*UserDataExport_DecisionEvent) Descriptor() ([]byte, []int) {
+	return file_explore_explore_service_proto_rawDescGZIP(), []int{45, 3}
+}
+
+func (x *UserDataExport_DecisionEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *UserDataExport_DecisionEvent) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *UserDataExport_DecisionEvent) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *UserDataExport_DecisionEvent) GetLikedRecipient() bool {
+	if x != nil {
+		return x.LikedRecipient
+	}
+	return false
+}
+
+func (x *UserDataExport_DecisionEvent) GetUnixTimestamp() uint64 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+var File_explore_explore_service_proto protoreflect.FileDescriptor
+
+const file_explore_explore_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dexplore/explore-service.proto\x12\aexplore\"\x93\x03\n" +
+	"\x13ListLikedYouRequest\x12*\n" +
+	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x12.\n" +
+	"\x10pagination_token\x18\x02 \x01(\tH\x00R\x0fpaginationToken\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01\x121\n" +
+	"\x05order\x18\x04 \x01(\x0e2\x16.explore.LikedYouOrderH\x02R\x05order\x88\x01\x01\x125\n" +
+	"\x14since_unix_timestamp\x18\x05 \x01(\x04H\x03R\x12sinceUnixTimestamp\x88\x01\x01\x125\n" +
+	"\x14until_unix_timestamp\x18\x06 \x01(\x04H\x04R\x12untilUnixTimestamp\x88\x01\x01B\x13\n" +
+	"\x11_pagination_tokenB\f\n" +
+	"\n" +
+	"_page_sizeB\b\n" +
+	"\x06_orderB\x17\n" +
+	"\x15_since_unix_timestampB\x17\n" +
+	"\x15_until_unix_timestamp\"\xf3\x02\n" +
+	"\x14ListLikedYouResponse\x12;\n" +
+	"\x06likers\x18\x01 \x03(\v2#.explore.ListLikedYouResponse.LikerR\x06likers\x127\n" +
+	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"seen_token\x18\x03 \x01(\tH\x01R\tseenToken\x88\x01\x01\x1a\x97\x01\n" +
+	"\x05Liker\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12%\n" +
+	"\x0eunix_timestamp\x18\x02 \x01(\x04R\runixTimestamp\x12\x16\n" +
+	"\x06unseen\x18\x03 \x01(\bR\x06unseen\x124\n" +
+	"\x16created_unix_timestamp\x18\x04 \x01(\x04R\x14createdUnixTimestampB\x18\n" +
+	"\x16_next_pagination_tokenB\r\n" +
+	"\v_seen_token\"\xe2\x01\n" +
+	"\x14CountLikedYouRequest\x12*\n" +
+	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x125\n" +
+	"\x14since_unix_timestamp\x18\x02 \x01(\x04H\x00R\x12sinceUnixTimestamp\x88\x01\x01\x125\n" +
+	"\x14until_unix_timestamp\x18\x03 \x01(\x04H\x01R\x12untilUnixTimestamp\x88\x01\x01B\x17\n" +
+	"\x15_since_unix_timestampB\x17\n" +
+	"\x15_until_unix_timestamp\"-\n" +
+	"\x15CountLikedYouResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\"{\n" +
+	"\x14WatchLikedYouRequest\x12*\n" +
+	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x12&\n" +
+	"\fresume_token\x18\x02 \x01(\tH\x00R\vresumeToken\x88\x01\x01B\x0f\n" +
+	"\r_resume_token\"\xda\x01\n" +
+	"\rLikedYouEvent\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.explore.LikedYouEventTypeR\x04type\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\tR\vactorUserId\x12\x1e\n" +
+	"\bmatch_id\x18\x03 \x01(\tH\x00R\amatchId\x88\x01\x01\x12%\n" +
+	"\x0eunix_timestamp\x18\x04 \x01(\x04R\runixTimestamp\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeTokenB\v\n" +
+	"\t_match_id\"b\n" +
+	"\x14MarkLikesSeenRequest\x12*\n" +
+	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x12\x1e\n" +
+	"\vup_to_token\x18\x02 \x01(\tR\tupToToken\"\x17\n" +
+	"\x15MarkLikesSeenResponse\"\xdf\x01\n" +
+	"\x13ListYouLikedRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12.\n" +
+	"\x10pagination_token\x18\x02 \x01(\tH\x00R\x0fpaginationToken\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01\x12/\n" +
+	"\x06filter\x18\x04 \x01(\x0e2\x17.explore.YouLikedFilterR\x06filterB\x13\n" +
+	"\x11_pagination_tokenB\f\n" +
+	"\n" +
+	"_page_size\"\x93\x02\n" +
+	"\x14ListYouLikedResponse\x12;\n" +
+	"\x06likees\x18\x01 \x03(\v2#.explore.ListYouLikedResponse.LikeeR\x06likees\x127\n" +
+	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01\x1ak\n" +
+	"\x05Likee\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\tR\vrecipientId\x12%\n" +
+	"\x0eunix_timestamp\x18\x02 \x01(\x04R\runixTimestamp\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\bR\amatchedB\x18\n" +
+	"\x16_next_pagination_token\"k\n" +
+	"\x14CountYouLikedRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12/\n" +
+	"\x06filter\x18\x02 \x01(\x0e2\x17.explore.YouLikedFilterR\x06filter\"-\n" +
+	"\x15CountYouLikedResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\"\xb7\x01\n" +
+	"\x16FilterUndecidedRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12,\n" +
+	"\x12candidate_user_ids\x18\x02 \x03(\tR\x10candidateUserIds\x123\n" +
+	"\x13pass_expiry_seconds\x18\x03 \x01(\x04H\x00R\x11passExpirySeconds\x88\x01\x01B\x16\n" +
+	"\x14_pass_expiry_seconds\"G\n" +
+	"\x17FilterUndecidedResponse\x12,\n" +
+	"\x12candidate_user_ids\x18\x01 \x03(\tR\x10candidateUserIds\"d\n" +
+	"\x12GetDecisionRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x02 \x01(\tR\x0frecipientUserId\"\x99\x03\n" +
+	"\rPairDecisions\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x02 \x01(\tR\x0frecipientUserId\x12F\n" +
+	"\x0eactor_decision\x18\x03 \x01(\v2\x1f.explore.PairDecisions.DecisionR\ractorDecision\x12N\n" +
+	"\x12recipient_decision\x18\x04 \x01(\v2\x1f.explore.PairDecisions.DecisionR\x11recipientDecision\x1a\x9f\x01\n" +
+	"\bDecision\x12'\n" +
+	"\x0fliked_recipient\x18\x01 \x01(\bR\x0elikedRecipient\x124\n" +
+	"\x16created_unix_timestamp\x18\x02 \x01(\x04R\x14createdUnixTimestamp\x124\n" +
+	"\x16updated_unix_timestamp\x18\x03 \x01(\x04R\x14updatedUnixTimestamp\"M\n" +
+	"\x18BatchGetDecisionsRequest\x121\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x1b.explore.GetDecisionRequestR\x05pairs\"I\n" +
+	"\x19BatchGetDecisionsResponse\x12,\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x16.explore.PairDecisionsR\x05pairs\"\x8d\x01\n" +
+	"\x12PutDecisionRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x02 \x01(\tR\x0frecipientUserId\x12'\n" +
+	"\x0fliked_recipient\x18\x03 \x01(\bR\x0elikedRecipient\"m\n" +
+	"\x13PutDecisionResponse\x12!\n" +
+	"\fmutual_likes\x18\x01 \x01(\bR\vmutualLikes\x12)\n" +
+	"\x05match\x18\x02 \x01(\v2\x0e.explore.MatchH\x00R\x05match\x88\x01\x01B\b\n" +
+	"\x06_match\"\xdf\x01\n" +
+	"\x13PutDecisionsRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12C\n" +
+	"\tdecisions\x18\x02 \x03(\v2%.explore.PutDecisionsRequest.DecisionR\tdecisions\x1a_\n" +
+	"\bDecision\x12*\n" +
+	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x12'\n" +
+	"\x0fliked_recipient\x18\x02 \x01(\bR\x0elikedRecipient\"\x8a\x02\n" +
+	"\x14PutDecisionsResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.explore.PutDecisionsResponse.ResultR\aresults\x1a\xb1\x01\n" +
+	"\x06Result\x12*\n" +
+	"\x11recipient_user_id\x18\x01 \x01(\tR\x0frecipientUserId\x12\x19\n" +
+	"\x05error\x18\x02 \x01(\tH\x00R\x05error\x88\x01\x01\x12!\n" +
+	"\fmutual_likes\x18\x03 \x01(\bR\vmutualLikes\x12)\n" +
+	"\x05match\x18\x04 \x01(\v2\x0e.explore.MatchH\x01R\x05match\x88\x01\x01B\b\n" +
+	"\x06_errorB\b\n" +
+	"\x06_match\"9\n" +
+	"\x13GetLikeQuotaRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\"\xf4\x01\n" +
+	"\tLikeQuota\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\tR\x04tier\x12\x1c\n" +
+	"\tunlimited\x18\x02 \x01(\bR\tunlimited\x12$\n" +
+	"\vdaily_limit\x18\x03 \x01(\rH\x00R\n" +
+	"dailyLimit\x88\x01\x01\x12!\n" +
+	"\tremaining\x18\x04 \x01(\rH\x01R\tremaining\x88\x01\x01\x125\n" +
+	"\x14reset_unix_timestamp\x18\x05 \x01(\x04H\x02R\x12resetUnixTimestamp\x88\x01\x01B\x0e\n" +
+	"\f_daily_limitB\f\n" +
+	"\n" +
+	"_remainingB\x17\n" +
+	"\x15_reset_unix_timestamp\"q\n" +
+	"\x05Match\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
+	"\x16matched_unix_timestamp\x18\x03 \x01(\x04R\x14matchedUnixTimestamp\"\xe1\x01\n" +
+	"\x1aListDecisionHistoryRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x02 \x01(\tR\x0frecipientUserId\x12.\n" +
+	"\x10pagination_token\x18\x03 \x01(\tH\x00R\x0fpaginationToken\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\rH\x01R\bpageSize\x88\x01\x01B\x13\n" +
+	"\x11_pagination_tokenB\f\n" +
+	"\n" +
+	"_page_size\"\xa8\x02\n" +
+	"\x1bListDecisionHistoryResponse\x12B\n" +
+	"\x06events\x18\x01 \x03(\v2*.explore.ListDecisionHistoryResponse.EventR\x06events\x127\n" +
+	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01\x1ar\n" +
+	"\x05Event\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12'\n" +
+	"\x0fliked_recipient\x18\x02 \x01(\bR\x0elikedRecipient\x12%\n" +
+	"\x0eunix_timestamp\x18\x03 \x01(\x04R\runixTimestampB\x18\n" +
+	"\x16_next_pagination_token\"\xa2\x01\n" +
+	"\x12ListMatchesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x10pagination_token\x18\x02 \x01(\tH\x00R\x0fpaginationToken\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01B\x13\n" +
+	"\x11_pagination_tokenB\f\n" +
+	"\n" +
+	"_page_size\"\x92\x01\n" +
+	"\x13ListMatchesResponse\x12(\n" +
+	"\amatches\x18\x01 \x03(\v2\x0e.explore.MatchR\amatches\x127\n" +
+	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01B\x18\n" +
+	"\x16_next_pagination_token\"X\n" +
+	"\x0eUnmatchRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\"\n" +
+	"\rother_user_id\x18\x02 \x01(\tR\votherUserId\"\x11\n" +
+	"\x0fUnmatchResponse\"^\n" +
+	"\x10BlockUserRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tR\rblockedUserId\"\x13\n" +
+	"\x11BlockUserResponse\"`\n" +
+	"\x12UnblockUserRequest\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tR\rblockedUserId\"\x15\n" +
+	"\x13UnblockUserResponse\"\xa7\x01\n" +
+	"\x17ListBlockedUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x10pagination_token\x18\x02 \x01(\tH\x00R\x0fpaginationToken\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01B\x13\n" +
+	"\x11_pagination_tokenB\f\n" +
+	"\n" +
+	"_page_size\"\x90\x02\n" +
+	"\x18ListBlockedUsersResponse\x12R\n" +
+	"\rblocked_users\x18\x01 \x03(\v2-.explore.ListBlockedUsersResponse.BlockedUserR\fblockedUsers\x127\n" +
+	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01\x1aM\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eunix_timestamp\x18\x02 \x01(\x04R\runixTimestampB\x18\n" +
+	"\x16_next_pagination_token\"\xa6\x02\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x124\n" +
+	"\x16created_unix_timestamp\x18\x03 \x01(\x04R\x14createdUnixTimestamp\x12A\n" +
+	"\x1adeactivated_unix_timestamp\x18\x04 \x01(\x04H\x00R\x18deactivatedUnixTimestamp\x88\x01\x01\x127\n" +
+	"\x15erased_unix_timestamp\x18\x05 \x01(\x04H\x01R\x13erasedUnixTimestamp\x88\x01\x01B\x1d\n" +
+	"\x1b_deactivated_unix_timestampB\x18\n" +
+	"\x16_erased_unix_timestamp\"/\n" +
+	"\x11CreateUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x14\n" +
+	"\x12DeleteUserResponse\"0\n" +
+	"\x15DeactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
+	"\x15ReactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xe5\n" +
+	"\n" +
+	"\x0eUserDataExport\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.explore.UserR\x04user\x12G\n" +
+	"\x0edecisions_made\x18\x02 \x03(\v2 .explore.UserDataExport.DecisionR\rdecisionsMade\x12O\n" +
+	"\x12decisions_received\x18\x03 \x03(\v2 .explore.UserDataExport.DecisionR\x11decisionsReceived\x127\n" +
+	"\amatches\x18\x04 \x03(\v2\x1d.explore.UserDataExport.MatchR\amatches\x125\n" +
+	"\x06blocks\x18\x05 \x03(\v2\x1d.explore.UserDataExport.BlockR\x06blocks\x126\n" +
+	"\x17exported_unix_timestamp\x18\x06 \x01(\x04R\x15exportedUnixTimestamp\x12W\n" +
+	"\x14decision_events_made\x18\a \x03(\v2%.explore.UserDataExport.DecisionEventR\x12decisionEventsMade\x12_\n" +
+	"\x18decision_events_received\x18\b \x03(\v2%.explore.UserDataExport.DecisionEventR\x16decisionEventsReceived\x1a\xef\x01\n" +
+	"\bDecision\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x02 \x01(\tR\x0frecipientUserId\x12'\n" +
+	"\x0fliked_recipient\x18\x03 \x01(\bR\x0elikedRecipient\x124\n" +
+	"\x16created_unix_timestamp\x18\x04 \x01(\x04R\x14createdUnixTimestamp\x124\n" +
+	"\x16updated_unix_timestamp\x18\x05 \x01(\x04R\x14updatedUnixTimestamp\x1a\x9c\x02\n" +
+	"\x05Match\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
+	"\x16matched_unix_timestamp\x18\x03 \x01(\x04R\x14matchedUnixTimestamp\x12=\n" +
+	"\x18unmatched_unix_timestamp\x18\x04 \x01(\x04H\x00R\x16unmatchedUnixTimestamp\x88\x01\x01\x124\n" +
+	"\x14unmatched_by_user_id\x18\x05 \x01(\tH\x01R\x11unmatchedByUserId\x88\x01\x01B\x1b\n" +
+	"\x19_unmatched_unix_timestampB\x17\n" +
+	"\x15_unmatched_by_user_id\x1aV\n" +
+	"\x05Block\x12&\n" +
+	"\x0fblocked_user_id\x18\x01 \x01(\tR\rblockedUserId\x12%\n" +
+	"\x0eunix_timestamp\x18\x02 \x01(\x04R\runixTimestamp\x1a\xca\x01\n" +
+	"\rDecisionEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\tR\vactorUserId\x12*\n" +
+	"\x11recipient_user_id\x18\x03 \x01(\tR\x0frecipientUserId\x12'\n" +
+	"\x0fliked_recipient\x18\x04 \x01(\bR\x0elikedRecipient\x12%\n" +
+	"\x0eunix_timestamp\x18\x05 \x01(\x04R\runixTimestamp\"/\n" +
+	"\x14EraseUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xad\x02\n" +
+	"\x15EraseUserDataResponse\x12\x1d\n" +
+	"\n" +
+	"erasure_id\x18\x01 \x01(\tR\terasureId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x128\n" +
+	"\x18requested_unix_timestamp\x18\x03 \x01(\x04R\x16requestedUnixTimestamp\x12+\n" +
+	"\x11decisions_deleted\x18\x04 \x01(\x04R\x10decisionsDeleted\x12'\n" +
+	"\x0fmatches_deleted\x18\x05 \x01(\x04R\x0ematchesDeleted\x12%\n" +
+	"\x0eblocks_deleted\x18\x06 \x01(\x04R\rblocksDeleted\x12%\n" +
+	"\x0ealready_erased\x18\a \x01(\bR\ralreadyErased\"\x88\x02\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1e\n" +
+	"\bmatch_id\x18\x01 \x01(\tH\x00R\amatchId\x88\x01\x01\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.explore.WebhookDeliveryStatusH\x01R\x06status\x88\x01\x01\x12.\n" +
+	"\x10pagination_token\x18\x03 \x01(\tH\x02R\x0fpaginationToken\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\rH\x03R\bpageSize\x88\x01\x01B\v\n" +
+	"\t_match_idB\t\n" +
+	"\a_statusB\x13\n" +
+	"\x11_pagination_tokenB\f\n" +
+	"\n" +
+	"_page_size\"\xa6\x04\n" +
+	"\x0fWebhookDelivery\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\x04R\n" +
+	"deliveryId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x19\n" +
+	"\bmatch_id\x18\x03 \x01(\tR\amatchId\x12!\n" +
+	"\fendpoint_url\x18\x04 \x01(\tR\vendpointUrl\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.explore.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\rR\battempts\x12-\n" +
+	"\x10last_status_code\x18\a \x01(\rH\x00R\x0elastStatusCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tH\x01R\tlastError\x88\x01\x01\x124\n" +
+	"\x16created_unix_timestamp\x18\t \x01(\x04R\x14createdUnixTimestamp\x124\n" +
+	"\x16updated_unix_timestamp\x18\n" +
+	" \x01(\x04R\x14updatedUnixTimestamp\x12B\n" +
+	"\x1bnext_attempt_unix_timestamp\x18\v \x01(\x04H\x02R\x18nextAttemptUnixTimestamp\x88\x01\x01B\x13\n" +
+	"\x11_last_status_codeB\r\n" +
+	"\v_last_errorB\x1e\n" +
+	"\x1c_next_attempt_unix_timestamp\"\xac\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.explore.WebhookDeliveryR\n" +
+	"deliveries\x127\n" +
+	"\x15next_pagination_token\x18\x02 \x01(\tH\x00R\x13nextPaginationToken\x88\x01\x01B\x18\n" +
+	"\x16_next_pagination_token*w\n" +
+	"\rLikedYouOrder\x12\x1f\n" +
+	"\x1bLIKED_YOU_ORDER_FIRST_LIKED\x10\x00\x12#\n" +
+	"\x1fLIKED_YOU_ORDER_RECENT_ACTIVITY\x10\x01\x12 \n" +
+	"\x1cLIKED_YOU_ORDER_OLDEST_FIRST\x10\x02*\x99\x01\n" +
+	"\x11LikedYouEventType\x12$\n" +
+	" LIKED_YOU_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19LIKED_YOU_EVENT_TYPE_LIKE\x10\x01\x12\x1e\n" +
+	"\x1aLIKED_YOU_EVENT_TYPE_MATCH\x10\x02\x12\x1f\n" +
+	"\x1bLIKED_YOU_EVENT_TYPE_RESYNC\x10\x03*f\n" +
+	"\x0eYouLikedFilter\x12\x18\n" +
+	"\x14YOU_LIKED_FILTER_ALL\x10\x00\x12\x1c\n" +
+	"\x18YOU_LIKED_FILTER_PENDING\x10\x01\x12\x1c\n" +
+	"\x18YOU_LIKED_FILTER_MATCHED\x10\x02*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_DELIVERED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x032\xe2\x10\n" +
+	"\x0eExploreService\x12K\n" +
+	"\fListLikedYou\x12\x1c.explore.ListLikedYouRequest\x1a\x1d.explore.ListLikedYouResponse\x12N\n" +
+	"\x0fListNewLikedYou\x12\x1c.explore.ListLikedYouRequest\x1a\x1d.explore.ListLikedYouResponse\x12N\n" +
+	"\rCountLikedYou\x12\x1d.explore.CountLikedYouRequest\x1a\x1e.explore.CountLikedYouResponse\x12T\n" +
+	"\x13CountUnseenLikedYou\x12\x1d.explore.CountLikedYouRequest\x1a\x1e.explore.CountLikedYouResponse\x12N\n" +
+	"\rMarkLikesSeen\x12\x1d.explore.MarkLikesSeenRequest\x1a\x1e.explore.MarkLikesSeenResponse\x12H\n" +
+	"\rWatchLikedYou\x12\x1d.explore.WatchLikedYouRequest\x1a\x16.explore.LikedYouEvent0\x01\x12K\n" +
+	"\fListYouLiked\x12\x1c.explore.ListYouLikedRequest\x1a\x1d.explore.ListYouLikedResponse\x12N\n" +
+	"\rCountYouLiked\x12\x1d.explore.CountYouLikedRequest\x1a\x1e.explore.CountYouLikedResponse\x12T\n" +
+	"\x0fFilterUndecided\x12\x1f.explore.FilterUndecidedRequest\x1a .explore.FilterUndecidedResponse\x12B\n" +
+	"\vGetDecision\x12\x1b.explore.GetDecisionRequest\x1a\x16.explore.PairDecisions\x12Z\n" +
+	"\x11BatchGetDecisions\x12!.explore.BatchGetDecisionsRequest\x1a\".explore.BatchGetDecisionsResponse\x12H\n" +
+	"\vPutDecision\x12\x1b.explore.PutDecisionRequest\x1a\x1c.explore.PutDecisionResponse\x12K\n" +
+	"\fPutDecisions\x12\x1c.explore.PutDecisionsRequest\x1a\x1d.explore.PutDecisionsResponse\x12@\n" +
+	"\fGetLikeQuota\x12\x1c.explore.GetLikeQuotaRequest\x1a\x12.explore.LikeQuota\x12`\n" +
+	"\x13ListDecisionHistory\x12#.explore.ListDecisionHistoryRequest\x1a$.explore.ListDecisionHistoryResponse\x12H\n" +
+	"\vListMatches\x12\x1b.explore.ListMatchesRequest\x1a\x1c.explore.ListMatchesResponse\x12<\n" +
+	"\aUnmatch\x12\x17.explore.UnmatchRequest\x1a\x18.explore.UnmatchResponse\x127\n" +
+	"\n" +
+	"CreateUser\x12\x1a.explore.CreateUserRequest\x1a\r.explore.User\x121\n" +
+	"\aGetUser\x12\x17.explore.GetUserRequest\x1a\r.explore.User\x12E\n" +
+	"\n" +
+	"DeleteUser\x12\x1a.explore.DeleteUserRequest\x1a\x1b.explore.DeleteUserResponse\x12?\n" +
+	"\x0eDeactivateUser\x12\x1e.explore.DeactivateUserRequest\x1a\r.explore.User\x12?\n" +
+	"\x0eReactivateUser\x12\x1e.explore.ReactivateUserRequest\x1a\r.explore.User\x12I\n" +
+	"\x0eExportUserData\x12\x1e.explore.ExportUserDataRequest\x1a\x17.explore.UserDataExport\x12N\n" +
+	"\rEraseUserData\x12\x1d.explore.EraseUserDataRequest\x1a\x1e.explore.EraseUserDataResponse\x12f\n" +
+	"\x15ListWebhookDeliveries\x12%.explore.ListWebhookDeliveriesRequest\x1a&.explore.ListWebhookDeliveriesResponse\x12B\n" +
+	"\tBlockUser\x12\x19.explore.BlockUserRequest\x1a\x1a.explore.BlockUserResponse\x12H\n" +
+	"\vUnblockUser\x12\x1b.explore.UnblockUserRequest\x1a\x1c.explore.UnblockUserResponse\x12W\n" +
+	"\x10ListBlockedUsers\x12 .explore.ListBlockedUsersRequest\x1a!.explore.ListBlockedUsersResponseB:Z8github.com/jacob-alt-del/explore-service/explore;exploreb\x06proto3"
 
 var (
 	file_explore_explore_service_proto_rawDescOnce sync.Once
@@ -424,31 +4118,165 @@ func file_explore_explore_service_proto_rawDescGZIP() []byte {
 	return file_explore_explore_service_proto_rawDescData
 }
 
-var file_explore_explore_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_explore_explore_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_explore_explore_service_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_explore_explore_service_proto_goTypes = []any{
-	(*ListLikedYouRequest)(nil),        // 0: explore.ListLikedYouRequest
-	(*ListLikedYouResponse)(nil),       // 1: explore.ListLikedYouResponse
-	(*CountLikedYouRequest)(nil),       // 2: explore.CountLikedYouRequest
-	(*CountLikedYouResponse)(nil),      // 3: explore.CountLikedYouResponse
-	(*PutDecisionRequest)(nil),         // 4: explore.PutDecisionRequest
-	(*PutDecisionResponse)(nil),        // 5: explore.PutDecisionResponse
-	(*ListLikedYouResponse_Liker)(nil), // 6: explore.ListLikedYouResponse.Liker
+	(LikedYouOrder)(0),                           // 0: explore.LikedYouOrder
+	(LikedYouEventType)(0),                       // 1: explore.LikedYouEventType
+	(YouLikedFilter)(0),                          // 2: explore.YouLikedFilter
+	(WebhookDeliveryStatus)(0),                   // 3: explore.WebhookDeliveryStatus
+	(*ListLikedYouRequest)(nil),                  // 4: explore.ListLikedYouRequest
+	(*ListLikedYouResponse)(nil),                 // 5: explore.ListLikedYouResponse
+	(*CountLikedYouRequest)(nil),                 // 6: explore.CountLikedYouRequest
+	(*CountLikedYouResponse)(nil),                // 7: explore.CountLikedYouResponse
+	(*WatchLikedYouRequest)(nil),                 // 8: explore.WatchLikedYouRequest
+	(*LikedYouEvent)(nil),                        // 9: explore.LikedYouEvent
+	(*MarkLikesSeenRequest)(nil),                 // 10: explore.MarkLikesSeenRequest
+	(*MarkLikesSeenResponse)(nil),                // 11: explore.MarkLikesSeenResponse
+	(*ListYouLikedRequest)(nil),                  // 12: explore.ListYouLikedRequest
+	(*ListYouLikedResponse)(nil),                 // 13: explore.ListYouLikedResponse
+	(*CountYouLikedRequest)(nil),                 // 14: explore.CountYouLikedRequest
+	(*CountYouLikedResponse)(nil),                // 15: explore.CountYouLikedResponse
+	(*FilterUndecidedRequest)(nil),               // 16: explore.FilterUndecidedRequest
+	(*FilterUndecidedResponse)(nil),              // 17: explore.FilterUndecidedResponse
+	(*GetDecisionRequest)(nil),                   // 18: explore.GetDecisionRequest
+	(*PairDecisions)(nil),                        // 19: explore.PairDecisions
+	(*BatchGetDecisionsRequest)(nil),             // 20: explore.BatchGetDecisionsRequest
+	(*BatchGetDecisionsResponse)(nil),            // 21: explore.BatchGetDecisionsResponse
+	(*PutDecisionRequest)(nil),                   // 22: explore.PutDecisionRequest
+	(*PutDecisionResponse)(nil),                  // 23: explore.PutDecisionResponse
+	(*PutDecisionsRequest)(nil),                  // 24: explore.PutDecisionsRequest
+	(*PutDecisionsResponse)(nil),                 // 25: explore.PutDecisionsResponse
+	(*GetLikeQuotaRequest)(nil),                  // 26: explore.GetLikeQuotaRequest
+	(*LikeQuota)(nil),                            // 27: explore.LikeQuota
+	(*Match)(nil),                                // 28: explore.Match
+	(*ListDecisionHistoryRequest)(nil),           // 29: explore.ListDecisionHistoryRequest
+	(*ListDecisionHistoryResponse)(nil),          // 30: explore.ListDecisionHistoryResponse
+	(*ListMatchesRequest)(nil),                   // 31: explore.ListMatchesRequest
+	(*ListMatchesResponse)(nil),                  // 32: explore.ListMatchesResponse
+	(*UnmatchRequest)(nil),                       // 33: explore.UnmatchRequest
+	(*UnmatchResponse)(nil),                      // 34: explore.UnmatchResponse
+	(*BlockUserRequest)(nil),                     // 35: explore.BlockUserRequest
+	(*BlockUserResponse)(nil),                    // 36: explore.BlockUserResponse
+	(*UnblockUserRequest)(nil),                   // 37: explore.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                  // 38: explore.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),              // 39: explore.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),             // 40: explore.ListBlockedUsersResponse
+	(*User)(nil),                                 // 41: explore.User
+	(*CreateUserRequest)(nil),                    // 42: explore.CreateUserRequest
+	(*GetUserRequest)(nil),                       // 43: explore.GetUserRequest
+	(*DeleteUserRequest)(nil),                    // 44: explore.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                   // 45: explore.DeleteUserResponse
+	(*DeactivateUserRequest)(nil),                // 46: explore.DeactivateUserRequest
+	(*ReactivateUserRequest)(nil),                // 47: explore.ReactivateUserRequest
+	(*ExportUserDataRequest)(nil),                // 48: explore.ExportUserDataRequest
+	(*UserDataExport)(nil),                       // 49: explore.UserDataExport
+	(*EraseUserDataRequest)(nil),                 // 50: explore.EraseUserDataRequest
+	(*EraseUserDataResponse)(nil),                // 51: explore.EraseUserDataResponse
+	(*ListWebhookDeliveriesRequest)(nil),         // 52: explore.ListWebhookDeliveriesRequest
+	(*WebhookDelivery)(nil),                      // 53: explore.WebhookDelivery
+	(*ListWebhookDeliveriesResponse)(nil),        // 54: explore.ListWebhookDeliveriesResponse
+	(*ListLikedYouResponse_Liker)(nil),           // 55: explore.ListLikedYouResponse.Liker
+	(*ListYouLikedResponse_Likee)(nil),           // 56: explore.ListYouLikedResponse.Likee
+	(*PairDecisions_Decision)(nil),               // 57: explore.PairDecisions.Decision
+	(*PutDecisionsRequest_Decision)(nil),         // 58: explore.PutDecisionsRequest.Decision
+	(*PutDecisionsResponse_Result)(nil),          // 59: explore.PutDecisionsResponse.Result
+	(*ListDecisionHistoryResponse_Event)(nil),    // 60: explore.ListDecisionHistoryResponse.Event
+	(*ListBlockedUsersResponse_BlockedUser)(nil), // 61: explore.ListBlockedUsersResponse.BlockedUser
+	(*UserDataExport_Decision)(nil),              // 62: explore.UserDataExport.Decision
+	(*UserDataExport_Match)(nil),                 // 63: explore.UserDataExport.Match
+	(*UserDataExport_Block)(nil),                 // 64: explore.UserDataExport.Block
+	(*UserDataExport_DecisionEvent)(nil),         // 65: explore.UserDataExport.DecisionEvent
 }
 var file_explore_explore_service_proto_depIdxs = []int32{
-	6, // 0: explore.ListLikedYouResponse.likers:type_name -> explore.ListLikedYouResponse.Liker
-	0, // 1: explore.ExploreService.ListLikedYou:input_type -> explore.ListLikedYouRequest
-	0, // 2: explore.ExploreService.ListNewLikedYou:input_type -> explore.ListLikedYouRequest
-	2, // 3: explore.ExploreService.CountLikedYou:input_type -> explore.CountLikedYouRequest
-	4, // 4: explore.ExploreService.PutDecision:input_type -> explore.PutDecisionRequest
-	1, // 5: explore.ExploreService.ListLikedYou:output_type -> explore.ListLikedYouResponse
-	1, // 6: explore.ExploreService.ListNewLikedYou:output_type -> explore.ListLikedYouResponse
-	3, // 7: explore.ExploreService.CountLikedYou:output_type -> explore.CountLikedYouResponse
-	5, // 8: explore.ExploreService.PutDecision:output_type -> explore.PutDecisionResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: explore.ListLikedYouRequest.order:type_name -> explore.LikedYouOrder
+	55, // 1: explore.ListLikedYouResponse.likers:type_name -> explore.ListLikedYouResponse.Liker
+	1,  // 2: explore.LikedYouEvent.type:type_name -> explore.LikedYouEventType
+	2,  // 3: explore.ListYouLikedRequest.filter:type_name -> explore.YouLikedFilter
+	56, // 4: explore.ListYouLikedResponse.likees:type_name -> explore.ListYouLikedResponse.Likee
+	2,  // 5: explore.CountYouLikedRequest.filter:type_name -> explore.YouLikedFilter
+	57, // 6: explore.PairDecisions.actor_decision:type_name -> explore.PairDecisions.Decision
+	57, // 7: explore.PairDecisions.recipient_decision:type_name -> explore.PairDecisions.Decision
+	18, // 8: explore.BatchGetDecisionsRequest.pairs:type_name -> explore.GetDecisionRequest
+	19, // 9: explore.BatchGetDecisionsResponse.pairs:type_name -> explore.PairDecisions
+	28, // 10: explore.PutDecisionResponse.match:type_name -> explore.Match
+	58, // 11: explore.PutDecisionsRequest.decisions:type_name -> explore.PutDecisionsRequest.Decision
+	59, // 12: explore.PutDecisionsResponse.results:type_name -> explore.PutDecisionsResponse.Result
+	60, // 13: explore.ListDecisionHistoryResponse.events:type_name -> explore.ListDecisionHistoryResponse.Event
+	28, // 14: explore.ListMatchesResponse.matches:type_name -> explore.Match
+	61, // 15: explore.ListBlockedUsersResponse.blocked_users:type_name -> explore.ListBlockedUsersResponse.BlockedUser
+	41, // 16: explore.UserDataExport.user:type_name -> explore.User
+	62, // 17: explore.UserDataExport.decisions_made:type_name -> explore.UserDataExport.Decision
+	62, // 18: explore.UserDataExport.decisions_received:type_name -> explore.UserDataExport.Decision
+	63, // 19: explore.UserDataExport.matches:type_name -> explore.UserDataExport.Match
+	64, // 20: explore.UserDataExport.blocks:type_name -> explore.UserDataExport.Block
+	65, // 21: explore.UserDataExport.decision_events_made:type_name -> explore.UserDataExport.DecisionEvent
+	65, // 22: explore.UserDataExport.decision_events_received:type_name -> explore.UserDataExport.DecisionEvent
+	3,  // 23: explore.ListWebhookDeliveriesRequest.status:type_name -> explore.WebhookDeliveryStatus
+	3,  // 24: explore.WebhookDelivery.status:type_name -> explore.WebhookDeliveryStatus
+	53, // 25: explore.ListWebhookDeliveriesResponse.deliveries:type_name -> explore.WebhookDelivery
+	28, // 26: explore.PutDecisionsResponse.Result.match:type_name -> explore.Match
+	4,  // 27: explore.ExploreService.ListLikedYou:input_type -> explore.ListLikedYouRequest
+	4,  // 28: explore.ExploreService.ListNewLikedYou:input_type -> explore.ListLikedYouRequest
+	6,  // 29: explore.ExploreService.CountLikedYou:input_type -> explore.CountLikedYouRequest
+	6,  // 30: explore.ExploreService.CountUnseenLikedYou:input_type -> explore.CountLikedYouRequest
+	10, // 31: explore.ExploreService.MarkLikesSeen:input_type -> explore.MarkLikesSeenRequest
+	8,  // 32: explore.ExploreService.WatchLikedYou:input_type -> explore.WatchLikedYouRequest
+	12, // 33: explore.ExploreService.ListYouLiked:input_type -> explore.ListYouLikedRequest
+	14, // 34: explore.ExploreService.CountYouLiked:input_type -> explore.CountYouLikedRequest
+	16, // 35: explore.ExploreService.FilterUndecided:input_type -> explore.FilterUndecidedRequest
+	18, // 36: explore.ExploreService.GetDecision:input_type -> explore.GetDecisionRequest
+	20, // 37: explore.ExploreService.BatchGetDecisions:input_type -> explore.BatchGetDecisionsRequest
+	22, // 38: explore.ExploreService.PutDecision:input_type -> explore.PutDecisionRequest
+	24, // 39: explore.ExploreService.PutDecisions:input_type -> explore.PutDecisionsRequest
+	26, // 40: explore.ExploreService.GetLikeQuota:input_type -> explore.GetLikeQuotaRequest
+	29, // 41: explore.ExploreService.ListDecisionHistory:input_type -> explore.ListDecisionHistoryRequest
+	31, // 42: explore.ExploreService.ListMatches:input_type -> explore.ListMatchesRequest
+	33, // 43: explore.ExploreService.Unmatch:input_type -> explore.UnmatchRequest
+	42, // 44: explore.ExploreService.CreateUser:input_type -> explore.CreateUserRequest
+	43, // 45: explore.ExploreService.GetUser:input_type -> explore.GetUserRequest
+	44, // 46: explore.ExploreService.DeleteUser:input_type -> explore.DeleteUserRequest
+	46, // 47: explore.ExploreService.DeactivateUser:input_type -> explore.DeactivateUserRequest
+	47, // 48: explore.ExploreService.ReactivateUser:input_type -> explore.ReactivateUserRequest
+	48, // 49: explore.ExploreService.ExportUserData:input_type -> explore.ExportUserDataRequest
+	50, // 50: explore.ExploreService.EraseUserData:input_type -> explore.EraseUserDataRequest
+	52, // 51: explore.ExploreService.ListWebhookDeliveries:input_type -> explore.ListWebhookDeliveriesRequest
+	35, // 52: explore.ExploreService.BlockUser:input_type -> explore.BlockUserRequest
+	37, // 53: explore.ExploreService.UnblockUser:input_type -> explore.UnblockUserRequest
+	39, // 54: explore.ExploreService.ListBlockedUsers:input_type -> explore.ListBlockedUsersRequest
+	5,  // 55: explore.ExploreService.ListLikedYou:output_type -> explore.ListLikedYouResponse
+	5,  // 56: explore.ExploreService.ListNewLikedYou:output_type -> explore.ListLikedYouResponse
+	7,  // 57: explore.ExploreService.CountLikedYou:output_type -> explore.CountLikedYouResponse
+	7,  // 58: explore.ExploreService.CountUnseenLikedYou:output_type -> explore.CountLikedYouResponse
+	11, // 59: explore.ExploreService.MarkLikesSeen:output_type -> explore.MarkLikesSeenResponse
+	9,  // 60: explore.ExploreService.WatchLikedYou:output_type -> explore.LikedYouEvent
+	13, // 61: explore.ExploreService.ListYouLiked:output_type -> explore.ListYouLikedResponse
+	15, // 62: explore.ExploreService.CountYouLiked:output_type -> explore.CountYouLikedResponse
+	17, // 63: explore.ExploreService.FilterUndecided:output_type -> explore.FilterUndecidedResponse
+	19, // 64: explore.ExploreService.GetDecision:output_type -> explore.PairDecisions
+	21, // 65: explore.ExploreService.BatchGetDecisions:output_type -> explore.BatchGetDecisionsResponse
+	23, // 66: explore.ExploreService.PutDecision:output_type -> explore.PutDecisionResponse
+	25, // 67: explore.ExploreService.PutDecisions:output_type -> explore.PutDecisionsResponse
+	27, // 68: explore.ExploreService.GetLikeQuota:output_type -> explore.LikeQuota
+	30, // 69: explore.ExploreService.ListDecisionHistory:output_type -> explore.ListDecisionHistoryResponse
+	32, // 70: explore.ExploreService.ListMatches:output_type -> explore.ListMatchesResponse
+	34, // 71: explore.ExploreService.Unmatch:output_type -> explore.UnmatchResponse
+	41, // 72: explore.ExploreService.CreateUser:output_type -> explore.User
+	41, // 73: explore.ExploreService.GetUser:output_type -> explore.User
+	45, // 74: explore.ExploreService.DeleteUser:output_type -> explore.DeleteUserResponse
+	41, // 75: explore.ExploreService.DeactivateUser:output_type -> explore.User
+	41, // 76: explore.ExploreService.ReactivateUser:output_type -> explore.User
+	49, // 77: explore.ExploreService.ExportUserData:output_type -> explore.UserDataExport
+	51, // 78: explore.ExploreService.EraseUserData:output_type -> explore.EraseUserDataResponse
+	54, // 79: explore.ExploreService.ListWebhookDeliveries:output_type -> explore.ListWebhookDeliveriesResponse
+	36, // 80: explore.ExploreService.BlockUser:output_type -> explore.BlockUserResponse
+	38, // 81: explore.ExploreService.UnblockUser:output_type -> explore.UnblockUserResponse
+	40, // 82: explore.ExploreService.ListBlockedUsers:output_type -> explore.ListBlockedUsersResponse
+	55, // [55:83] is the sub-list for method output_type
+	27, // [27:55] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_explore_explore_service_proto_init() }
@@ -458,18 +4286,39 @@ func file_explore_explore_service_proto_init() {
 	}
 	file_explore_explore_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_explore_explore_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[36].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[55].OneofWrappers = []any{}
+	file_explore_explore_service_proto_msgTypes[59].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_explore_explore_service_proto_rawDesc), len(file_explore_explore_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_explore_explore_service_proto_goTypes,
 		DependencyIndexes: file_explore_explore_service_proto_depIdxs,
+		EnumInfos:         file_explore_explore_service_proto_enumTypes,
 		MessageInfos:      file_explore_explore_service_proto_msgTypes,
 	}.Build()
 	File_explore_explore_service_proto = out.File
@@ -0,0 +1,31 @@
+package quota
+
+import "context"
+
+// Tier is the entitlement tier of a user, deciding their daily like quota.
+type Tier string
+
+const (
+	Free    Tier = "free"
+	Premium Tier = "premium"
+)
+
+// EntitlementProvider looks up the tier of a user, typically from the
+// subscription system.
+type EntitlementProvider interface {
+	Tier(ctx context.Context, userID string) (Tier, error)
+}
+
+// StaticProvider gives every user the Default tier except the ones listed in
+// Users, for running without a subscription system.
+type StaticProvider struct {
+	Default Tier
+	Users   map[string]Tier
+}
+
+func (p StaticProvider) Tier(ctx context.Context, userID string) (Tier, error) {
+	if tier, ok := p.Users[userID]; ok {
+		return tier, nil
+	}
+	return p.Default, nil
+}
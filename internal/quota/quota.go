@@ -0,0 +1,114 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+)
+
+// Store is the part of dataaccess.Store the Checker counts likes with.
+type Store interface {
+	ListRecentLikes(ctx context.Context, actorID string, sinceUnix int64) ([]dataaccess.RecentLike, error)
+}
+
+type Config struct {
+	// DailyLikes is how many recipients a user of each tier can like within
+	// Window, tiers missing from it are unlimited.
+	DailyLikes map[Tier]int
+	// Window is the rolling window likes are counted over.
+	Window time.Duration
+}
+
+// DefaultConfig returns the quotas used by the server: 50 likes a day for
+// free users, premium users are unlimited.
+func DefaultConfig() Config {
+	return Config{
+		DailyLikes: map[Tier]int{Free: 50},
+		Window:     24 * time.Hour,
+	}
+}
+
+// Checker computes like quotas from the likes recorded in the store. Each
+// liked recipient counts once per window however often they were liked, so
+// liking someone again, or after unliking, is always allowed. The quota is
+// checked before the like is recorded, concurrent likes of one actor can
+// overshoot it by the number in flight.
+type Checker struct {
+	store        Store
+	entitlements EntitlementProvider
+	cfg          Config
+	now          func() time.Time
+}
+
+func NewChecker(store Store, entitlements EntitlementProvider, cfg Config) *Checker {
+	return &Checker{
+		store:        store,
+		entitlements: entitlements,
+		cfg:          cfg,
+		now:          time.Now,
+	}
+}
+
+// Usage is the quota of an actor at one point in time.
+type Usage struct {
+	Tier      Tier
+	Unlimited bool
+	// Limit is how many recipients can be liked within the window.
+	Limit int
+	// Used is how many recipients were liked within the window.
+	Used int
+	// ResetUnix is when the oldest counted like leaves the window, freeing
+	// up a like, 0 when none are counted.
+	ResetUnix int64
+
+	liked map[string]bool
+}
+
+// Remaining returns how many more recipients can be liked, 0 when unlimited.
+func (u Usage) Remaining() int {
+	return max(u.Limit-u.Used, 0)
+}
+
+// Take reports whether the recipient can be liked, counting them if so.
+// Recipients already counted can always be liked again.
+func (u *Usage) Take(recipientID string) bool {
+	if u.Unlimited || u.liked[recipientID] {
+		return true
+	}
+	if u.Used >= u.Limit {
+		return false
+	}
+	u.Used++
+	u.liked[recipientID] = true
+	return true
+}
+
+// Usage returns the actor's current quota. Likes aren't counted for
+// unlimited tiers.
+func (c *Checker) Usage(ctx context.Context, actorID string) (Usage, error) {
+	tier, err := c.entitlements.Tier(ctx, actorID)
+	if err != nil {
+		return Usage{}, fmt.Errorf("error looking up tier: %w", err)
+	}
+	limit, ok := c.cfg.DailyLikes[tier]
+	if !ok {
+		return Usage{Tier: tier, Unlimited: true}, nil
+	}
+
+	likes, err := c.store.ListRecentLikes(ctx, actorID, c.now().Add(-c.cfg.Window).Unix())
+	if err != nil {
+		return Usage{}, err
+	}
+
+	usage := Usage{Tier: tier, Limit: limit, Used: len(likes), liked: map[string]bool{}}
+	for _, l := range likes {
+		usage.liked[l.RecipientID] = true
+	}
+	if len(likes) > 0 {
+		// likes are oldest first
+		usage.ResetUnix = likes[0].LikedAtUnix + int64(c.cfg.Window.Seconds())
+	}
+	return usage, nil
+}
@@ -0,0 +1,76 @@
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+)
+
+type fakeStore struct {
+	likes     []dataaccess.RecentLike
+	sinceUnix int64
+}
+
+func (s *fakeStore) ListRecentLikes(ctx context.Context, actorID string, sinceUnix int64) ([]dataaccess.RecentLike, error) {
+	s.sinceUnix = sinceUnix
+	var results []dataaccess.RecentLike
+	for _, l := range s.likes {
+		if l.LikedAtUnix >= sinceUnix {
+			results = append(results, l)
+		}
+	}
+	return results, nil
+}
+
+func TestChecker_Usage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1730000000, 0)
+	store := &fakeStore{likes: []dataaccess.RecentLike{
+		{RecipientID: "expired", LikedAtUnix: now.Add(-25 * time.Hour).Unix()},
+		{RecipientID: "a", LikedAtUnix: now.Add(-20 * time.Hour).Unix()},
+		{RecipientID: "b", LikedAtUnix: now.Add(-time.Hour).Unix()},
+	}}
+	checker := NewChecker(store, StaticProvider{Default: Free, Users: map[string]Tier{"vip": Premium}}, Config{
+		DailyLikes: map[Tier]int{Free: 3},
+		Window:     24 * time.Hour,
+	})
+	checker.now = func() time.Time { return now }
+
+	usage, err := checker.Usage(ctx, "actor")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if store.sinceUnix != now.Add(-24*time.Hour).Unix() {
+		t.Errorf("expected likes to be counted over the last day, got since %d", store.sinceUnix)
+	}
+	if usage.Tier != Free || usage.Unlimited || usage.Limit != 3 || usage.Used != 2 || usage.Remaining() != 1 {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+	if want := now.Add(4 * time.Hour).Unix(); usage.ResetUnix != want {
+		t.Errorf("expected the oldest like to free up at %d, got %d", want, usage.ResetUnix)
+	}
+
+	// recipients already counted don't take another like
+	for _, id := range []string{"a", "c", "a", "c"} {
+		if !usage.Take(id) {
+			t.Errorf("expected %s to be allowed", id)
+		}
+	}
+	if usage.Take("d") {
+		t.Errorf("expected d to be over the quota")
+	}
+	if usage.Remaining() != 0 {
+		t.Errorf("expected no likes remaining, got %d", usage.Remaining())
+	}
+
+	// tiers without a quota are unlimited
+	usage, err = checker.Usage(ctx, "vip")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if usage.Tier != Premium || !usage.Unlimited || !usage.Take("d") {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+}
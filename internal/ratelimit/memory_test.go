@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	clock := time.Unix(1730000000, 0)
+	s.now = func() time.Time { return clock }
+	limit := Every(60, time.Minute, 3)
+
+	take := func(key string, n int) Result {
+		t.Helper()
+		result, err := s.Take(ctx, key, limit, n)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return result
+	}
+
+	for i := 0; i < 3; i++ {
+		if result := take("a", 1); !result.Allowed {
+			t.Fatalf("expected take %d within the burst to be allowed", i)
+		}
+	}
+	if result := take("a", 1); result.Allowed || result.RetryAfter != time.Second {
+		t.Errorf("expected a rejection retrying in 1s, got %+v", result)
+	}
+	if result := take("b", 1); !result.Allowed {
+		t.Errorf("expected other keys to have their own bucket")
+	}
+
+	// a rejected take doesn't spend the partial token
+	clock = clock.Add(1500 * time.Millisecond)
+	if result := take("a", 2); result.Allowed || result.RetryAfter != 500*time.Millisecond {
+		t.Errorf("expected a rejection retrying in 500ms, got %+v", result)
+	}
+	if result := take("a", 1); !result.Allowed {
+		t.Errorf("expected the refilled token to be taken")
+	}
+
+	// more than the burst never fits
+	if result := take("c", 4); result.Allowed || result.RetryAfter != 0 {
+		t.Errorf("expected a rejection without a retry, got %+v", result)
+	}
+
+	// buckets that filled up again are dropped
+	clock = clock.Add(time.Hour)
+	take("d", 1)
+	if s.Len() != 1 {
+		t.Errorf("expected only the new bucket to be kept, got %d", s.Len())
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    Limit
+		wantErr bool
+	}{
+		{spec: "60/1m:20", want: Limit{Rate: 1, Burst: 20}},
+		{spec: "10/1s", want: Limit{Rate: 10, Burst: 10}},
+		{spec: "60", wantErr: true},
+		{spec: "0/1m", wantErr: true},
+		{spec: "60/minute", wantErr: true},
+		{spec: "60/1m:0", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLimit(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseLimit(%q) = %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+}
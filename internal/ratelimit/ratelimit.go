@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket holding up to Burst tokens, refilled at Rate tokens
+// per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Every returns the limit allowing n requests per interval, in bursts of up to burst.
+func Every(n int, interval time.Duration, burst int) Limit {
+	return Limit{Rate: float64(n) / interval.Seconds(), Burst: burst}
+}
+
+// ParseLimit parses a limit written as <requests>/<interval>[:<burst>], e.g.
+// 60/1m:20. The burst defaults to the number of requests.
+func ParseLimit(s string) (Limit, error) {
+	rate, burst, hasBurst := strings.Cut(s, ":")
+	requests, interval, ok := strings.Cut(rate, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q, expected <requests>/<interval>[:<burst>]", s)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n < 1 {
+		return Limit{}, fmt.Errorf("invalid limit %q, requests must be a positive integer", s)
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q, interval must be a positive duration", s)
+	}
+	b := n
+	if hasBurst {
+		if b, err = strconv.Atoi(burst); err != nil || b < 1 {
+			return Limit{}, fmt.Errorf("invalid limit %q, burst must be a positive integer", s)
+		}
+	}
+	return Every(n, d, b), nil
+}
+
+// Result is the outcome of a Take.
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long until the tokens that were asked for are
+	// available, zero when they were taken or can never be.
+	RetryAfter time.Duration
+}
+
+// Store keeps the token buckets. Take must be atomic per key, so requests
+// racing on one replica, or on several sharing a Store, can't both spend the
+// last token.
+type Store interface {
+	// Take takes n tokens from the key's bucket. When it holds fewer none are
+	// taken, so a rejected request doesn't push back the next one.
+	Take(ctx context.Context, key string, limit Limit, n int) (Result, error)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	err := validateBlockRequest(req.GetActorUserId(), req.GetBlockedUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.BlockUser(ctx, req.ActorUserId, req.BlockedUserId)
+	if err != nil {
+		return nil, userError("BlockUser", err)
+	}
+
+	return &pb.BlockUserResponse{}, nil
+}
+
+func (s *ExploreServiceServer) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	err := validateBlockRequest(req.GetActorUserId(), req.GetBlockedUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.UnblockUser(ctx, req.ActorUserId, req.BlockedUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "UnblockUser() error: %v", err)
+	}
+
+	return &pb.UnblockUserResponse{}, nil
+}
+
+func (s *ExploreServiceServer) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	err := validateListBlockedUsersRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = blockedUsersDefaultPageSize
+	}
+
+	cursor, err := pagination.Decode(req.GetPaginationToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
+	}
+
+	blocks, err := s.Repo.ListBlockedUsers(ctx, req.UserId, cursor, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "ListBlockedUsers() error: %v", err)
+	}
+
+	var blockedUsers []*pb.ListBlockedUsersResponse_BlockedUser
+	var nextToken string
+
+	// check if next page is needed
+	if len(blocks) > pageSize {
+		last := blocks[pageSize-1]
+		nextToken = pagination.Encode(pagination.Cursor{UnixTs: last.CreatedAtUnix, ID: last.BlockedID})
+		blocks = blocks[:pageSize]
+	}
+
+	for _, b := range blocks {
+		blockedUsers = append(blockedUsers, &pb.ListBlockedUsersResponse_BlockedUser{
+			UserId:        b.BlockedID,
+			UnixTimestamp: uint64(b.CreatedAtUnix),
+		})
+	}
+
+	return &pb.ListBlockedUsersResponse{
+		BlockedUsers:        blockedUsers,
+		NextPaginationToken: &nextToken,
+	}, nil
+}
+
+func validateBlockRequest(actorUserID, blockedUserID string) error {
+	errList := []string{}
+
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	if blockedUserID == "" {
+		errList = append(errList, "blocked_user_id is required")
+	}
+	if !uuidRegex.MatchString(blockedUserID) {
+		errList = append(errList, "blocked_user_id must be a valid UUID")
+	}
+
+	if actorUserID == blockedUserID {
+		errList = append(errList, "blocked_user_id must not equal actor_user_id")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
+
+func validateListBlockedUsersRequest(req *pb.ListBlockedUsersRequest) error {
+	errList := []string{}
+
+	userID := req.GetUserId()
+	if userID == "" {
+		errList = append(errList, "user_id is required")
+	}
+	if !uuidRegex.MatchString(userID) {
+		errList = append(errList, "user_id must be a valid UUID")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize > blockedUsersMaxPageSize {
+		errList = append(errList, fmt.Sprintf("page_size cannot exceed %v", blockedUsersMaxPageSize))
+	}
+
+	paginationToken := req.GetPaginationToken()
+	if paginationToken != "" {
+		if _, err := base64.StdEncoding.DecodeString(paginationToken); err != nil {
+			errList = append(errList, "pagination_token must be valid base64")
+		}
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlockUser_HidesPairInBothDirections(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+	blocker, harasser := testRecipientID, testActorID(1)
+
+	put := func(actor, recipient string) *pb.PutDecisionResponse {
+		resp, err := s.PutDecision(ctx, &pb.PutDecisionRequest{
+			ActorUserId:     actor,
+			RecipientUserId: recipient,
+			LikedRecipient:  true,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	put(harasser, blocker)
+	put(testActorID(2), blocker)
+
+	_, err := s.BlockUser(ctx, &pb.BlockUserRequest{ActorUserId: blocker, BlockedUserId: harasser})
+	require.NoError(t, err)
+
+	// the blocker liking back must not produce a match, neither must the blocked user re-liking
+	require.False(t, put(blocker, harasser).MutualLikes)
+	resp := put(harasser, blocker)
+	require.False(t, resp.MutualLikes)
+	require.Nil(t, resp.Match)
+
+	for _, tc := range []struct {
+		user      string
+		wantCount uint64
+	}{{blocker, 1}, {harasser, 0}} {
+		list, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: tc.user})
+		require.NoError(t, err)
+		require.Len(t, list.Likers, int(tc.wantCount))
+
+		newList, err := s.ListNewLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: tc.user})
+		require.NoError(t, err)
+		require.Len(t, newList.Likers, int(tc.wantCount))
+
+		count, err := s.CountLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: tc.user})
+		require.NoError(t, err)
+		require.Equal(t, tc.wantCount, count.Count)
+	}
+
+	blocked, err := s.ListBlockedUsers(ctx, &pb.ListBlockedUsersRequest{UserId: blocker})
+	require.NoError(t, err)
+	require.Len(t, blocked.BlockedUsers, 1)
+	require.Equal(t, harasser, blocked.BlockedUsers[0].UserId)
+
+	// unblocking restores the likes, the pair matches on the next like
+	_, err = s.UnblockUser(ctx, &pb.UnblockUserRequest{ActorUserId: blocker, BlockedUserId: harasser})
+	require.NoError(t, err)
+
+	count, err := s.CountLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: blocker})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count.Count)
+	require.True(t, put(harasser, blocker).MutualLikes)
+
+	blocked, err = s.ListBlockedUsers(ctx, &pb.ListBlockedUsersRequest{UserId: blocker})
+	require.NoError(t, err)
+	require.Empty(t, blocked.BlockedUsers)
+}
+
+func TestBlockUser_Validation(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	_, err := s.BlockUser(context.Background(), &pb.BlockUserRequest{ActorUserId: testRecipientID, BlockedUserId: testRecipientID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.UnblockUser(context.Background(), &pb.UnblockUserRequest{ActorUserId: testRecipientID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListBlockedUsers(context.Background(), &pb.ListBlockedUsersRequest{UserId: "nope"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
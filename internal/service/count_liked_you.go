@@ -15,7 +15,7 @@ func (s *ExploreServiceServer) CountLikedYou(ctx context.Context, req *pb.CountL
 		return nil, err
 	}
 
-	count, err := s.Repo.CountLikedYou(ctx, req.RecipientUserId)
+	count, err := s.Repo.CountLikedYou(ctx, req.RecipientUserId, toTimeWindow(req.SinceUnixTimestamp, req.UntilUnixTimestamp))
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "CountLikedYou error: %v", err)
 	}
@@ -36,6 +36,8 @@ func validateCountLikedYouRequest(req *pb.CountLikedYouRequest) error {
 		errList = append(errList, "recipient_user_id must be a valid UUID")
 	}
 
+	errList = append(errList, validateTimeWindow(req.SinceUnixTimestamp, req.UntilUnixTimestamp)...)
+
 	if len(errList) > 0 {
 		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
 	}
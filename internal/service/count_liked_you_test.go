@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCountLikedYou(t *testing.T) {
+	hourAgo := proto.Uint64(uint64(time.Now().Add(-time.Hour).Unix()))
+
+	tests := []struct {
+		name        string
+		req         *pb.CountLikedYouRequest
+		mockErr     error
+		wantErrCode codes.Code
+		wantCount   uint64
+	}{
+		{
+			name:      "counts likes only",
+			req:       &pb.CountLikedYouRequest{RecipientUserId: testRecipientID},
+			wantCount: 2,
+		},
+		{
+			name:        "repository error",
+			req:         &pb.CountLikedYouRequest{RecipientUserId: testRecipientID},
+			mockErr:     errors.New("db error"),
+			wantErrCode: codes.Unknown,
+		},
+		{
+			name:        "missing recipient",
+			req:         &pb.CountLikedYouRequest{},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:      "likes since an hour ago",
+			req:       &pb.CountLikedYouRequest{RecipientUserId: testRecipientID, SinceUnixTimestamp: hourAgo},
+			wantCount: 2,
+		},
+		{
+			name:      "likes until an hour ago",
+			req:       &pb.CountLikedYouRequest{RecipientUserId: testRecipientID, UntilUnixTimestamp: hourAgo},
+			wantCount: 0,
+		},
+		{
+			name: "empty window",
+			req: &pb.CountLikedYouRequest{
+				RecipientUserId:    testRecipientID,
+				SinceUnixTimestamp: hourAgo,
+				UntilUnixTimestamp: hourAgo,
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubStore()
+			ctx := context.Background()
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(1), testRecipientID, true))
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(2), testRecipientID, true))
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(3), testRecipientID, false))
+			repo.countLikedYouErr = tt.mockErr
+
+			s := NewExploreServiceServer(repo)
+			resp, err := s.CountLikedYou(ctx, tt.req)
+
+			if tt.wantErrCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantErrCode, st.Code(), "unexpected gRPC error code")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCount, resp.Count)
+		})
+	}
+}
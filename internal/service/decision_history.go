@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) ListDecisionHistory(ctx context.Context, req *pb.ListDecisionHistoryRequest) (*pb.ListDecisionHistoryResponse, error) {
+	err := validateListDecisionHistoryRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = decisionHistoryDefaultPageSize
+	}
+
+	// history tokens carry the id of the last event, the timestamp is informational
+	cursor, err := pagination.Decode(req.GetPaginationToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
+	}
+	var beforeID int64
+	if !cursor.IsZero() {
+		beforeID, err = strconv.ParseInt(cursor.ID, 10, 64)
+		if err != nil || beforeID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination_token: not a decision history token")
+		}
+	}
+
+	events, err := s.Repo.ListDecisionHistory(ctx, req.ActorUserId, req.RecipientUserId, beforeID, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "ListDecisionHistory() error: %v", err)
+	}
+
+	var nextToken string
+
+	// check if next page is needed
+	if len(events) > pageSize {
+		last := events[pageSize-1]
+		nextToken = pagination.Encode(pagination.Cursor{UnixTs: last.CreatedAtUnix, ID: strconv.FormatInt(last.ID, 10)})
+		events = events[:pageSize]
+	}
+
+	pbEvents := make([]*pb.ListDecisionHistoryResponse_Event, 0, len(events))
+	for _, e := range events {
+		pbEvents = append(pbEvents, &pb.ListDecisionHistoryResponse_Event{
+			EventId:        uint64(e.ID),
+			LikedRecipient: e.Liked,
+			UnixTimestamp:  uint64(e.CreatedAtUnix),
+		})
+	}
+
+	return &pb.ListDecisionHistoryResponse{
+		Events:              pbEvents,
+		NextPaginationToken: &nextToken,
+	}, nil
+}
+
+func validateListDecisionHistoryRequest(req *pb.ListDecisionHistoryRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	recipientUserID := req.GetRecipientUserId()
+	if recipientUserID == "" {
+		errList = append(errList, "recipient_user_id is required")
+	}
+	if !uuidRegex.MatchString(recipientUserID) {
+		errList = append(errList, "recipient_user_id must be a valid UUID")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize > decisionHistoryMaxPageSize {
+		errList = append(errList, fmt.Sprintf("page_size cannot exceed %v", decisionHistoryMaxPageSize))
+	}
+
+	paginationToken := req.GetPaginationToken()
+	if paginationToken != "" {
+		if _, err := base64.StdEncoding.DecodeString(paginationToken); err != nil {
+			errList = append(errList, "pagination_token must be valid base64")
+		}
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListDecisionHistory(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	// like, pass, like, pass, like
+	for i := 0; i < 5; i++ {
+		_, err := s.PutDecision(ctx, &pb.PutDecisionRequest{
+			ActorUserId:     testActorID(0),
+			RecipientUserId: testRecipientID,
+			LikedRecipient:  i%2 == 0,
+		})
+		require.NoError(t, err)
+	}
+	_, err := s.PutDecisions(ctx, &pb.PutDecisionsRequest{
+		ActorUserId: testActorID(0),
+		Decisions:   []*pb.PutDecisionsRequest_Decision{{RecipientUserId: testActorID(1), LikedRecipient: true}},
+	})
+	require.NoError(t, err)
+
+	var liked []bool
+	var lastID uint64
+	token := ""
+	for {
+		resp, err := s.ListDecisionHistory(ctx, &pb.ListDecisionHistoryRequest{
+			ActorUserId:     testActorID(0),
+			RecipientUserId: testRecipientID,
+			PaginationToken: &token,
+			PageSize:        proto.Uint32(2),
+		})
+		require.NoError(t, err)
+		for _, e := range resp.Events {
+			if lastID != 0 {
+				require.Less(t, e.EventId, lastID)
+			}
+			lastID = e.EventId
+			liked = append(liked, e.LikedRecipient)
+		}
+		token = resp.GetNextPaginationToken()
+		if token == "" {
+			break
+		}
+	}
+	require.Equal(t, []bool{true, false, true, false, true}, liked)
+}
+
+func TestListDecisionHistory_InvalidToken(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	token := pagination.Encode(pagination.Cursor{UnixTs: 1730000000, ID: testActorID(1)})
+	_, err := s.ListDecisionHistory(context.Background(), &pb.ListDecisionHistoryRequest{
+		ActorUserId:     testActorID(0),
+		RecipientUserId: testRecipientID,
+		PaginationToken: &token,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
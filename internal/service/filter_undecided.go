@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) FilterUndecided(ctx context.Context, req *pb.FilterUndecidedRequest) (*pb.FilterUndecidedResponse, error) {
+	err := validateFilterUndecidedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the actor can never decide on themselves, so they are never a candidate
+	candidates := make([]string, 0, len(req.CandidateUserIds))
+	for _, id := range req.CandidateUserIds {
+		if id != req.ActorUserId {
+			candidates = append(candidates, id)
+		}
+	}
+
+	passExpiry := time.Duration(req.GetPassExpirySeconds()) * time.Second
+	undecided, err := s.Repo.FilterUndecided(ctx, req.ActorUserId, candidates, passExpiry)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "FilterUndecided() error: %v", err)
+	}
+
+	return &pb.FilterUndecidedResponse{
+		CandidateUserIds: undecided,
+	}, nil
+}
+
+func validateFilterUndecidedRequest(req *pb.FilterUndecidedRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	candidates := req.GetCandidateUserIds()
+	if len(candidates) > filterUndecidedMaxCandidates {
+		errList = append(errList, fmt.Sprintf("candidate_user_ids cannot exceed %v items", filterUndecidedMaxCandidates))
+	}
+	for i, id := range candidates {
+		if !uuidRegex.MatchString(id) {
+			errList = append(errList, fmt.Sprintf("candidate_user_ids[%d] must be a valid UUID", i))
+		}
+	}
+
+	// keep the cutoff representable as a time.Duration
+	if req.GetPassExpirySeconds() > uint64(time.Duration(1<<63-1)/time.Second) {
+		errList = append(errList, "pass_expiry_seconds is too large")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFilterUndecided(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	actor := testRecipientID
+	_, err := s.PutDecisions(ctx, &pb.PutDecisionsRequest{
+		ActorUserId: actor,
+		Decisions: []*pb.PutDecisionsRequest_Decision{
+			{RecipientUserId: testActorID(1), LikedRecipient: true},
+			{RecipientUserId: testActorID(3), LikedRecipient: false},
+		},
+	})
+	require.NoError(t, err)
+	// decisions made by the candidates don't matter
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testActorID(4), RecipientUserId: actor, LikedRecipient: true})
+	require.NoError(t, err)
+
+	resp, err := s.FilterUndecided(ctx, &pb.FilterUndecidedRequest{
+		ActorUserId: actor,
+		CandidateUserIds: []string{
+			testActorID(4), testActorID(3), actor, testActorID(2), testActorID(1), testActorID(4),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{testActorID(4), testActorID(2)}, resp.CandidateUserIds)
+
+	resp, err = s.FilterUndecided(ctx, &pb.FilterUndecidedRequest{ActorUserId: actor})
+	require.NoError(t, err)
+	require.Empty(t, resp.CandidateUserIds)
+}
+
+func TestFilterUndecided_Validation(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	tooMany := make([]string, filterUndecidedMaxCandidates+1)
+	for i := range tooMany {
+		tooMany[i] = testActorID(i % testUsers)
+	}
+	for _, req := range []*pb.FilterUndecidedRequest{
+		{ActorUserId: "not-a-uuid"},
+		{ActorUserId: testRecipientID, CandidateUserIds: []string{"not-a-uuid"}},
+		{ActorUserId: testRecipientID, CandidateUserIds: tooMany},
+	} {
+		_, err := s.FilterUndecided(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) GetDecision(ctx context.Context, req *pb.GetDecisionRequest) (*pb.PairDecisions, error) {
+	errList := pairErrors("", req)
+	if len(errList) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	results, err := s.Repo.GetDecisions(ctx, []dataaccess.DecisionPair{toDecisionPair(req)})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "GetDecisions() error: %v", err)
+	}
+
+	return toPBPairDecisions(req, results[0]), nil
+}
+
+func (s *ExploreServiceServer) BatchGetDecisions(ctx context.Context, req *pb.BatchGetDecisionsRequest) (*pb.BatchGetDecisionsResponse, error) {
+	err := validateBatchGetDecisionsRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]dataaccess.DecisionPair, len(req.Pairs))
+	for i, p := range req.Pairs {
+		pairs[i] = toDecisionPair(p)
+	}
+
+	results, err := s.Repo.GetDecisions(ctx, pairs)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "GetDecisions() error: %v", err)
+	}
+
+	resp := &pb.BatchGetDecisionsResponse{
+		Pairs: make([]*pb.PairDecisions, len(results)),
+	}
+	for i, r := range results {
+		resp.Pairs[i] = toPBPairDecisions(req.Pairs[i], r)
+	}
+
+	return resp, nil
+}
+
+func toDecisionPair(req *pb.GetDecisionRequest) dataaccess.DecisionPair {
+	return dataaccess.DecisionPair{ActorID: req.ActorUserId, RecipientID: req.RecipientUserId}
+}
+
+func toPBPairDecisions(req *pb.GetDecisionRequest, d dataaccess.PairDecisions) *pb.PairDecisions {
+	return &pb.PairDecisions{
+		ActorUserId:       req.ActorUserId,
+		RecipientUserId:   req.RecipientUserId,
+		ActorDecision:     toPBDecision(d.Outgoing),
+		RecipientDecision: toPBDecision(d.Incoming),
+	}
+}
+
+func toPBDecision(d *dataaccess.DecisionRecord) *pb.PairDecisions_Decision {
+	if d == nil {
+		return nil
+	}
+	return &pb.PairDecisions_Decision{
+		LikedRecipient:       d.Liked,
+		CreatedUnixTimestamp: uint64(d.CreatedAtUnix),
+		UpdatedUnixTimestamp: uint64(d.UpdatedAtUnix),
+	}
+}
+
+func validateBatchGetDecisionsRequest(req *pb.BatchGetDecisionsRequest) error {
+	errList := []string{}
+
+	pairs := req.GetPairs()
+	if len(pairs) > batchGetDecisionsMaxPairs {
+		errList = append(errList, fmt.Sprintf("pairs cannot exceed %v items", batchGetDecisionsMaxPairs))
+	}
+	for i, p := range pairs {
+		errList = append(errList, pairErrors(fmt.Sprintf("pairs[%d].", i), p)...)
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
+
+// pairErrors validates a single pair, prefix is prepended to the field names.
+func pairErrors(prefix string, req *pb.GetDecisionRequest) []string {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, prefix+"actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, prefix+"actor_user_id must be a valid UUID")
+	}
+
+	recipientUserID := req.GetRecipientUserId()
+	if recipientUserID == "" {
+		errList = append(errList, prefix+"recipient_user_id is required")
+	}
+	if !uuidRegex.MatchString(recipientUserID) {
+		errList = append(errList, prefix+"recipient_user_id must be a valid UUID")
+	}
+
+	if actorUserID == recipientUserID {
+		errList = append(errList, prefix+"recipient_user_id must not equal actor_user_id")
+	}
+
+	return errList
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetDecision(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+	viewer, other := testRecipientID, testActorID(1)
+
+	resp, err := s.GetDecision(ctx, &pb.GetDecisionRequest{ActorUserId: viewer, RecipientUserId: other})
+	require.NoError(t, err)
+	require.Nil(t, resp.ActorDecision)
+	require.Nil(t, resp.RecipientDecision)
+
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: other, RecipientUserId: viewer, LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: viewer, RecipientUserId: other, LikedRecipient: false})
+	require.NoError(t, err)
+
+	resp, err = s.GetDecision(ctx, &pb.GetDecisionRequest{ActorUserId: viewer, RecipientUserId: other})
+	require.NoError(t, err)
+	require.Equal(t, viewer, resp.ActorUserId)
+	require.Equal(t, other, resp.RecipientUserId)
+	require.False(t, resp.ActorDecision.LikedRecipient)
+	require.NotZero(t, resp.ActorDecision.CreatedUnixTimestamp)
+	require.NotZero(t, resp.ActorDecision.UpdatedUnixTimestamp)
+	require.True(t, resp.RecipientDecision.LikedRecipient)
+
+	// a block hides the other user's decision but not the viewer's own
+	_, err = s.BlockUser(ctx, &pb.BlockUserRequest{ActorUserId: other, BlockedUserId: viewer})
+	require.NoError(t, err)
+
+	resp, err = s.GetDecision(ctx, &pb.GetDecisionRequest{ActorUserId: viewer, RecipientUserId: other})
+	require.NoError(t, err)
+	require.NotNil(t, resp.ActorDecision)
+	require.Nil(t, resp.RecipientDecision)
+}
+
+func TestBatchGetDecisions(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+	viewer := testRecipientID
+
+	_, err := s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: viewer, RecipientUserId: testActorID(1), LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testActorID(2), RecipientUserId: viewer, LikedRecipient: true})
+	require.NoError(t, err)
+
+	resp, err := s.BatchGetDecisions(ctx, &pb.BatchGetDecisionsRequest{
+		Pairs: []*pb.GetDecisionRequest{
+			{ActorUserId: viewer, RecipientUserId: testActorID(2)},
+			{ActorUserId: viewer, RecipientUserId: testActorID(3)},
+			{ActorUserId: viewer, RecipientUserId: testActorID(1)},
+			{ActorUserId: testActorID(1), RecipientUserId: viewer},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Pairs, 4)
+
+	require.Equal(t, testActorID(2), resp.Pairs[0].RecipientUserId)
+	require.Nil(t, resp.Pairs[0].ActorDecision)
+	require.True(t, resp.Pairs[0].RecipientDecision.LikedRecipient)
+
+	require.Nil(t, resp.Pairs[1].ActorDecision)
+	require.Nil(t, resp.Pairs[1].RecipientDecision)
+
+	require.True(t, resp.Pairs[2].ActorDecision.LikedRecipient)
+	require.Nil(t, resp.Pairs[2].RecipientDecision)
+
+	// the same pair seen from the other side
+	require.Nil(t, resp.Pairs[3].ActorDecision)
+	require.True(t, resp.Pairs[3].RecipientDecision.LikedRecipient)
+}
+
+func TestBatchGetDecisions_Validation(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	tooMany := make([]*pb.GetDecisionRequest, batchGetDecisionsMaxPairs+1)
+	for i := range tooMany {
+		tooMany[i] = &pb.GetDecisionRequest{ActorUserId: testRecipientID, RecipientUserId: testActorID(i % testUsers)}
+	}
+
+	for _, req := range []*pb.BatchGetDecisionsRequest{
+		{Pairs: tooMany},
+		{Pairs: []*pb.GetDecisionRequest{{ActorUserId: testRecipientID, RecipientUserId: "not-a-uuid"}}},
+		{Pairs: []*pb.GetDecisionRequest{{ActorUserId: testRecipientID, RecipientUserId: testRecipientID}}},
+	} {
+		_, err := s.BatchGetDecisions(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	_, err := s.GetDecision(context.Background(), &pb.GetDecisionRequest{ActorUserId: testRecipientID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
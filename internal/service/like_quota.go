@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/quota"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// LikeQuotaExceededReason is the ErrorInfo reason of likes rejected by the
+// daily like quota, telling them apart from rate limited requests.
+const LikeQuotaExceededReason = "LIKE_QUOTA_EXCEEDED"
+
+const likeQuotaExceededMessage = "daily like quota exceeded"
+
+func (s *ExploreServiceServer) GetLikeQuota(ctx context.Context, req *pb.GetLikeQuotaRequest) (*pb.LikeQuota, error) {
+	err := validateGetLikeQuotaRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.Repo.GetUser(ctx, req.ActorUserId); err != nil {
+		return nil, userError("GetUser", err)
+	}
+	if s.Quota == nil {
+		return &pb.LikeQuota{Unlimited: true}, nil
+	}
+
+	usage, err := s.Quota.Usage(ctx, req.ActorUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "Usage() error: %v", err)
+	}
+
+	return toPBLikeQuota(usage), nil
+}
+
+func toPBLikeQuota(u quota.Usage) *pb.LikeQuota {
+	resp := &pb.LikeQuota{
+		Tier:      string(u.Tier),
+		Unlimited: u.Unlimited,
+	}
+	if u.Unlimited {
+		return resp
+	}
+
+	dailyLimit := uint32(u.Limit)
+	remaining := uint32(u.Remaining())
+	resp.DailyLimit = &dailyLimit
+	resp.Remaining = &remaining
+	if u.ResetUnix != 0 {
+		resetAt := uint64(u.ResetUnix)
+		resp.ResetUnixTimestamp = &resetAt
+	}
+	return resp
+}
+
+// likeQuotaError is the ResourceExhausted error of a like over the actor's
+// quota, with an ErrorInfo carrying LikeQuotaExceededReason and a RetryInfo
+// until a like frees up.
+func likeQuotaError(u quota.Usage) error {
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: LikeQuotaExceededReason,
+		Domain: "explore",
+		Metadata: map[string]string{
+			"tier":        string(u.Tier),
+			"daily_limit": strconv.Itoa(u.Limit),
+		},
+	}}
+	if retryIn := time.Until(time.Unix(u.ResetUnix, 0)); u.ResetUnix != 0 && retryIn > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryIn)})
+	}
+
+	st := status.New(codes.ResourceExhausted, likeQuotaExceededMessage)
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func validateGetLikeQuotaRequest(req *pb.GetLikeQuotaRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/quota"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newQuotaServer(dailyLikes int) (*ExploreServiceServer, *stubStore) {
+	repo := newStubStore()
+	s := NewExploreServiceServer(repo)
+	s.Quota = quota.NewChecker(repo, quota.StaticProvider{
+		Default: quota.Free,
+		Users:   map[string]quota.Tier{testActorID(99): quota.Premium},
+	}, quota.Config{
+		DailyLikes: map[quota.Tier]int{quota.Free: dailyLikes},
+		Window:     24 * time.Hour,
+	})
+	return s, repo
+}
+
+func TestPutDecision_LikeQuota(t *testing.T) {
+	s, _ := newQuotaServer(2)
+	ctx := context.Background()
+	put := func(actorID, recipientID string, liked bool) error {
+		_, err := s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: actorID, RecipientUserId: recipientID, LikedRecipient: liked})
+		return err
+	}
+
+	require.NoError(t, put(testActorID(0), testActorID(1), true))
+	require.NoError(t, put(testActorID(0), testActorID(2), true))
+
+	err := put(testActorID(0), testActorID(3), true)
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, LikeQuotaExceededReason, info.Reason)
+	require.Equal(t, "free", info.Metadata["tier"])
+	retryInfo, ok := st.Details()[1].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.InDelta(t, 24*time.Hour, retryInfo.RetryDelay.AsDuration(), float64(time.Minute))
+
+	// passes are always allowed, and so are recipients already liked today
+	require.NoError(t, put(testActorID(0), testActorID(3), false))
+	require.NoError(t, put(testActorID(0), testActorID(1), false))
+	require.NoError(t, put(testActorID(0), testActorID(1), true))
+
+	// premium users are unlimited
+	for i := 0; i < 5; i++ {
+		require.NoError(t, put(testActorID(99), testActorID(i), true))
+	}
+}
+
+func TestPutDecisions_LikeQuota(t *testing.T) {
+	s, repo := newQuotaServer(2)
+	ctx := context.Background()
+
+	_, err := repo.RecordDecision(ctx, testActorID(0), testActorID(1), true)
+	require.NoError(t, err)
+
+	resp, err := s.PutDecisions(ctx, &pb.PutDecisionsRequest{
+		ActorUserId: testActorID(0),
+		Decisions: []*pb.PutDecisionsRequest_Decision{
+			{RecipientUserId: testActorID(2), LikedRecipient: true},
+			{RecipientUserId: testActorID(3), LikedRecipient: true},
+			{RecipientUserId: testActorID(4), LikedRecipient: false},
+			{RecipientUserId: testActorID(1), LikedRecipient: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 4)
+
+	require.Nil(t, resp.Results[0].Error)
+	require.Equal(t, likeQuotaExceededMessage, resp.Results[1].GetError())
+	require.Nil(t, resp.Results[2].Error)
+	require.Nil(t, resp.Results[3].Error)
+
+	// the over quota like was not recorded
+	decision, err := s.GetDecision(ctx, &pb.GetDecisionRequest{ActorUserId: testActorID(0), RecipientUserId: testActorID(3)})
+	require.NoError(t, err)
+	require.Nil(t, decision.ActorDecision)
+}
+
+func TestGetLikeQuota(t *testing.T) {
+	s, repo := newQuotaServer(3)
+	ctx := context.Background()
+
+	resp, err := s.GetLikeQuota(ctx, &pb.GetLikeQuotaRequest{ActorUserId: testActorID(0)})
+	require.NoError(t, err)
+	require.Equal(t, "free", resp.Tier)
+	require.False(t, resp.Unlimited)
+	require.Equal(t, uint32(3), resp.GetDailyLimit())
+	require.Equal(t, uint32(3), resp.GetRemaining())
+	require.Nil(t, resp.ResetUnixTimestamp)
+
+	_, err = repo.RecordDecision(ctx, testActorID(0), testActorID(1), true)
+	require.NoError(t, err)
+	_, err = repo.RecordDecision(ctx, testActorID(0), testActorID(2), false)
+	require.NoError(t, err)
+
+	resp, err = s.GetLikeQuota(ctx, &pb.GetLikeQuotaRequest{ActorUserId: testActorID(0)})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), resp.GetRemaining())
+	require.InDelta(t, time.Now().Add(24*time.Hour).Unix(), int64(resp.GetResetUnixTimestamp()), 60)
+
+	resp, err = s.GetLikeQuota(ctx, &pb.GetLikeQuotaRequest{ActorUserId: testActorID(99)})
+	require.NoError(t, err)
+	require.Equal(t, "premium", resp.Tier)
+	require.True(t, resp.Unlimited)
+	require.Nil(t, resp.DailyLimit)
+	require.Nil(t, resp.Remaining)
+
+	// without a quota everyone is unlimited
+	s.Quota = nil
+	resp, err = s.GetLikeQuota(ctx, &pb.GetLikeQuotaRequest{ActorUserId: testActorID(0)})
+	require.NoError(t, err)
+	require.True(t, resp.Unlimited)
+}
+
+func TestGetLikeQuota_Errors(t *testing.T) {
+	s, _ := newQuotaServer(3)
+	ctx := context.Background()
+
+	_, err := s.GetLikeQuota(ctx, &pb.GetLikeQuotaRequest{ActorUserId: "not-a-uuid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.GetLikeQuota(ctx, &pb.GetLikeQuotaRequest{ActorUserId: "00000000-0000-0000-0000-000000000000"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// seenTokenOrder marks the cursors of seen tokens, so a pagination token
+// positioned by another column can't be stored as the watermark and a seen
+// token can't be used to page.
+const seenTokenOrder = "seen"
+
+// encodeSeenToken returns the seen token for the liker at position, in
+// (updated_at, actor_id) order.
+func encodeSeenToken(position pagination.Cursor) string {
+	position.Order = seenTokenOrder
+	return pagination.Encode(position)
+}
+
+func (s *ExploreServiceServer) MarkLikesSeen(ctx context.Context, req *pb.MarkLikesSeenRequest) (*pb.MarkLikesSeenResponse, error) {
+	err := validateMarkLikesSeenRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	upTo, err := pagination.Decode(req.UpToToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid up_to_token: %v", err)
+	}
+	// timestamp-only tokens can't say which likers of that second were seen
+	if upTo.IsLegacy() {
+		return nil, status.Error(codes.InvalidArgument, "invalid up_to_token: token is missing its position")
+	}
+	if upTo.Order != seenTokenOrder {
+		return nil, status.Error(codes.InvalidArgument, "invalid up_to_token: not a seen_token")
+	}
+	upTo.Order = ""
+
+	if err := s.Repo.MarkLikesSeen(ctx, req.RecipientUserId, upTo); err != nil {
+		return nil, userError("MarkLikesSeen", err)
+	}
+
+	return &pb.MarkLikesSeenResponse{}, nil
+}
+
+func (s *ExploreServiceServer) CountUnseenLikedYou(ctx context.Context, req *pb.CountLikedYouRequest) (*pb.CountLikedYouResponse, error) {
+	err := validateCountLikedYouRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the seen watermark already is the lower bound of the count
+	if req.SinceUnixTimestamp != nil || req.UntilUnixTimestamp != nil {
+		return nil, status.Error(codes.InvalidArgument, "since_unix_timestamp and until_unix_timestamp are not supported by CountUnseenLikedYou")
+	}
+
+	count, err := s.Repo.CountUnseenLikedYou(ctx, req.RecipientUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "CountUnseenLikedYou() error: %v", err)
+	}
+
+	return &pb.CountLikedYouResponse{
+		Count: count,
+	}, nil
+}
+
+func validateMarkLikesSeenRequest(req *pb.MarkLikesSeenRequest) error {
+	errList := []string{}
+
+	recipientUserID := req.GetRecipientUserId()
+	if recipientUserID == "" {
+		errList = append(errList, "recipient_user_id is required")
+	}
+	if !uuidRegex.MatchString(recipientUserID) {
+		errList = append(errList, "recipient_user_id must be a valid UUID")
+	}
+
+	if req.GetUpToToken() == "" {
+		errList = append(errList, "up_to_token is required")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarkLikesSeen(t *testing.T) {
+	repo := newStubStore()
+	ctx := context.Background()
+	s := NewExploreServiceServer(repo)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, repo.UpsertDecision(ctx, testActorID(i), testRecipientID, true))
+	}
+
+	recentActivity := pb.LikedYouOrder_LIKED_YOU_ORDER_RECENT_ACTIVITY
+	unseen := func() (uint64, map[string]bool, *pb.ListLikedYouResponse) {
+		count, err := s.CountUnseenLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: testRecipientID})
+		require.NoError(t, err)
+		list, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID, Order: &recentActivity})
+		require.NoError(t, err)
+		flags := map[string]bool{}
+		for _, l := range list.Likers {
+			flags[l.ActorId] = l.Unseen
+		}
+		return count.Count, flags, list
+	}
+
+	count, flags, list := unseen()
+	require.Equal(t, uint64(3), count)
+	require.Equal(t, map[string]bool{testActorID(1): true, testActorID(2): true, testActorID(3): true}, flags)
+
+	_, err := s.MarkLikesSeen(ctx, &pb.MarkLikesSeenRequest{RecipientUserId: testRecipientID, UpToToken: list.GetSeenToken()})
+	require.NoError(t, err)
+
+	count, flags, _ = unseen()
+	require.Zero(t, count)
+	require.Equal(t, map[string]bool{testActorID(1): false, testActorID(2): false, testActorID(3): false}, flags)
+
+	// a stale device marking an older position doesn't move the watermark back
+	stale, err := repo.ListLikedYou(ctx, testRecipientID, dataaccess.LikedYouRecentActivity, dataaccess.TimeWindow{}, pagination.Cursor{}, 5)
+	require.NoError(t, err)
+	oldest := stale[len(stale)-1]
+	_, err = s.MarkLikesSeen(ctx, &pb.MarkLikesSeenRequest{
+		RecipientUserId: testRecipientID,
+		UpToToken:       encodeSeenToken(pagination.Cursor{UnixTs: oldest.UpdatedAtUnix, ID: oldest.ActorID}),
+	})
+	require.NoError(t, err)
+
+	count, _, _ = unseen()
+	require.Zero(t, count)
+
+	// new likes after the watermark are unseen again
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(4), testRecipientID, true))
+	count, flags, _ = unseen()
+	require.Equal(t, uint64(1), count)
+	require.True(t, flags[testActorID(4)])
+	require.False(t, flags[testActorID(3)])
+}
+
+func TestListLikedYou_SeenTokenOrders(t *testing.T) {
+	ctx := context.Background()
+
+	for _, order := range []pb.LikedYouOrder{
+		pb.LikedYouOrder_LIKED_YOU_ORDER_FIRST_LIKED,
+		pb.LikedYouOrder_LIKED_YOU_ORDER_OLDEST_FIRST,
+		pb.LikedYouOrder_LIKED_YOU_ORDER_RECENT_ACTIVITY,
+	} {
+		t.Run(order.String(), func(t *testing.T) {
+			repo := newStubStore()
+			s := NewExploreServiceServer(repo)
+
+			for i := 1; i <= 3; i++ {
+				require.NoError(t, repo.UpsertDecision(ctx, testActorID(i), testRecipientID, true))
+			}
+			// testActorID(1) re-likes so its updated_at and created_at orders differ
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(1), testRecipientID, false))
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(1), testRecipientID, true))
+
+			page, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID, Order: &order, PageSize: proto.Uint32(1)})
+			require.NoError(t, err)
+			require.Len(t, page.Likers, 1)
+			require.NotNil(t, page.SeenToken)
+
+			_, err = s.MarkLikesSeen(ctx, &pb.MarkLikesSeenRequest{RecipientUserId: testRecipientID, UpToToken: page.GetSeenToken()})
+			require.NoError(t, err)
+
+			// the listed liker is seen and the count agrees with the flags
+			all, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID, Order: &order})
+			require.NoError(t, err)
+			unseen := uint64(0)
+			for _, l := range all.Likers {
+				if l.ActorId == page.Likers[0].ActorId {
+					require.False(t, l.Unseen)
+				}
+				if l.Unseen {
+					unseen++
+				}
+			}
+			count, err := s.CountUnseenLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: testRecipientID})
+			require.NoError(t, err)
+			require.Equal(t, unseen, count.Count)
+			require.Less(t, count.Count, uint64(3))
+		})
+	}
+}
+
+func TestMarkLikesSeen_Errors(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+	token := encodeSeenToken(pagination.Cursor{UnixTs: 1730000000, ID: testActorID(1)})
+
+	tests := []struct {
+		name string
+		req  *pb.MarkLikesSeenRequest
+		want codes.Code
+	}{
+		{"invalid recipient", &pb.MarkLikesSeenRequest{RecipientUserId: "not-a-uuid", UpToToken: token}, codes.InvalidArgument},
+		{"missing token", &pb.MarkLikesSeenRequest{RecipientUserId: testRecipientID}, codes.InvalidArgument},
+		{"garbage token", &pb.MarkLikesSeenRequest{RecipientUserId: testRecipientID, UpToToken: "!!"}, codes.InvalidArgument},
+		{
+			"legacy token",
+			&pb.MarkLikesSeenRequest{RecipientUserId: testRecipientID, UpToToken: base64.StdEncoding.EncodeToString([]byte("1730000000"))},
+			codes.InvalidArgument,
+		},
+		{
+			"pagination token",
+			&pb.MarkLikesSeenRequest{
+				RecipientUserId: testRecipientID,
+				UpToToken:       pagination.Encode(pagination.Cursor{UnixTs: 1730000000, ID: testActorID(1), Order: "first_liked"}),
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"token without an order",
+			&pb.MarkLikesSeenRequest{RecipientUserId: testRecipientID, UpToToken: pagination.Encode(pagination.Cursor{UnixTs: 1730000000, ID: testActorID(1)})},
+			codes.InvalidArgument,
+		},
+		{"unknown recipient", &pb.MarkLikesSeenRequest{RecipientUserId: testActorID(testUsers), UpToToken: token}, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.MarkLikesSeen(ctx, tt.req)
+			require.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
 	"github.com/jacob-alt-del/explore-service/internal/pagination"
 	pb "github.com/jacob-alt-del/explore-service/internal/proto"
 	"google.golang.org/grpc/codes"
@@ -23,36 +24,153 @@ func (s *ExploreServiceServer) ListLikedYou(ctx context.Context, req *pb.ListLik
 		pageSize = likedYouDefaultPageSize
 	}
 
-	paginationUnix, err := pagination.Decode(req.GetPaginationToken())
+	cursor, err := pagination.Decode(req.GetPaginationToken())
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "Decode() error: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
 	}
 
-	decisions, err := s.Repo.ListLikedYou(ctx, req.RecipientUserId, paginationUnix, pageSize)
+	order, err := likedYouOrder(req, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.Repo.ListLikedYou(ctx, req.RecipientUserId, order, toTimeWindow(req.SinceUnixTimestamp, req.UntilUnixTimestamp), cursor, pageSize)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "ListLikedYou() error: %v", err)
 	}
 
+	return s.likedYouResponse(ctx, req.RecipientUserId, order, decisions, pageSize)
+}
+
+// likedYouResponse builds a ListLikedYou or ListNewLikedYou page from up to
+// pageSize+1 decisions, flagging the likes newer than the seen watermark.
+func (s *ExploreServiceServer) likedYouResponse(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	decisions []dataaccess.Decision,
+	pageSize int,
+) (*pb.ListLikedYouResponse, error) {
 	var likers []*pb.ListLikedYouResponse_Liker
 	var nextToken string
 
 	// check if next page is needed
 	if len(decisions) > pageSize {
-		nextToken = pagination.Encode(decisions[pageSize-1].UpdatedAtUnix)
+		last := decisions[pageSize-1]
+		nextToken = pagination.Encode(pagination.Cursor{
+			UnixTs: order.SortTime(last),
+			ID:     last.ActorID,
+			Order:  likedYouOrderNames[order],
+		})
 		decisions = decisions[:pageSize]
 	}
 
+	resp := &pb.ListLikedYouResponse{
+		NextPaginationToken: &nextToken,
+	}
+	if len(decisions) == 0 {
+		return resp, nil
+	}
+
+	seen, err := s.Repo.GetLikesSeen(ctx, recipientID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "GetLikesSeen() error: %v", err)
+	}
+
+	var newest pagination.Cursor
 	for _, d := range decisions {
+		position := pagination.Cursor{UnixTs: d.UpdatedAtUnix, ID: d.ActorID}
+		if newest.Less(position) {
+			newest = position
+		}
 		likers = append(likers, &pb.ListLikedYouResponse_Liker{
-			ActorId:       d.ActorID,
-			UnixTimestamp: uint64(d.UpdatedAtUnix),
+			ActorId:              d.ActorID,
+			UnixTimestamp:        uint64(d.UpdatedAtUnix),
+			CreatedUnixTimestamp: uint64(d.CreatedAtUnix),
+			Unseen:               seen.Less(position),
 		})
 	}
 
-	return &pb.ListLikedYouResponse{
-		Likers:              likers,
-		NextPaginationToken: &nextToken,
-	}, nil
+	seenToken := encodeSeenToken(newest)
+	resp.Likers = likers
+	resp.SeenToken = &seenToken
+	return resp, nil
+}
+
+func toTimeWindow(since, until *uint64) dataaccess.TimeWindow {
+	var window dataaccess.TimeWindow
+	if since != nil {
+		window.SinceUnix = int64(*since)
+	}
+	if until != nil {
+		window.UntilUnix = int64(*until)
+	}
+	return window
+}
+
+// validateTimeWindow checks the optional since/until bounds of a liked you
+// list or count.
+func validateTimeWindow(since, until *uint64) []string {
+	errList := []string{}
+
+	const maxUnix = 1<<63 - 1
+	if since != nil && (*since == 0 || *since > maxUnix) {
+		errList = append(errList, "since_unix_timestamp must be a positive unix timestamp")
+	}
+	if until != nil && (*until == 0 || *until > maxUnix) {
+		errList = append(errList, "until_unix_timestamp must be a positive unix timestamp")
+	}
+	if since != nil && until != nil && *since >= *until {
+		errList = append(errList, "since_unix_timestamp must be before until_unix_timestamp")
+	}
+
+	return errList
+}
+
+// likedYouOrderNames are the orderings as encoded in pagination tokens.
+var likedYouOrderNames = map[dataaccess.LikedYouOrder]string{
+	dataaccess.LikedYouFirstLiked:     "first_liked",
+	dataaccess.LikedYouRecentActivity: "recent_activity",
+	dataaccess.LikedYouOldestFirst:    "oldest_first",
+}
+
+func toLikedYouOrder(order pb.LikedYouOrder) dataaccess.LikedYouOrder {
+	switch order {
+	case pb.LikedYouOrder_LIKED_YOU_ORDER_RECENT_ACTIVITY:
+		return dataaccess.LikedYouRecentActivity
+	case pb.LikedYouOrder_LIKED_YOU_ORDER_OLDEST_FIRST:
+		return dataaccess.LikedYouOldestFirst
+	default:
+		return dataaccess.LikedYouFirstLiked
+	}
+}
+
+// likedYouOrder resolves the ordering of a liked you page. A token keeps the
+// ordering it was issued for and is rejected when the request asks for another
+// one, tokens issued before there were several orderings belong to the recent
+// activity order.
+func likedYouOrder(req *pb.ListLikedYouRequest, cursor pagination.Cursor) (dataaccess.LikedYouOrder, error) {
+	if cursor.IsZero() {
+		return toLikedYouOrder(req.GetOrder()), nil
+	}
+
+	tokenOrder := dataaccess.LikedYouRecentActivity
+	if cursor.Order != "" {
+		found := false
+		for order, name := range likedYouOrderNames {
+			if name == cursor.Order {
+				tokenOrder, found = order, true
+			}
+		}
+		if !found {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid pagination_token: unknown order %q", cursor.Order)
+		}
+	}
+
+	if req.Order != nil && toLikedYouOrder(*req.Order) != tokenOrder {
+		return 0, status.Error(codes.InvalidArgument, "invalid pagination_token: token belongs to another order")
+	}
+	return tokenOrder, nil
 }
 
 func validateListLikedYouRequest(req *pb.ListLikedYouRequest) error {
@@ -71,6 +189,12 @@ func validateListLikedYouRequest(req *pb.ListLikedYouRequest) error {
 		errList = append(errList, fmt.Sprintf("page_size cannot exceed %v", likedYouMaxPageSize))
 	}
 
+	if _, ok := pb.LikedYouOrder_name[int32(req.GetOrder())]; !ok {
+		errList = append(errList, fmt.Sprintf("order %d is not supported", req.GetOrder()))
+	}
+
+	errList = append(errList, validateTimeWindow(req.SinceUnixTimestamp, req.UntilUnixTimestamp)...)
+
 	paginationToken := req.GetPaginationToken()
 	if paginationToken != "" {
 		if _, err := base64.StdEncoding.DecodeString(paginationToken); err != nil {
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListLikedYou_PagesThroughEveryLiker(t *testing.T) {
+	repo := newStubStore()
+	ctx := context.Background()
+
+	// likes are recorded within the same second so every row shares updated_at
+	const likers = 12
+	for i := 0; i < likers; i++ {
+		require.NoError(t, repo.UpsertDecision(ctx, testActorID(i), testRecipientID, true))
+	}
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(99), testRecipientID, false))
+
+	s := NewExploreServiceServer(repo)
+
+	seen := map[string]bool{}
+	var token *string
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, likers, "pagination did not terminate")
+
+		resp, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{
+			RecipientUserId: testRecipientID,
+			PaginationToken: token,
+			PageSize:        proto.Uint32(5),
+		})
+		require.NoError(t, err)
+		for _, l := range resp.Likers {
+			require.False(t, seen[l.ActorId], "liker %s returned twice", l.ActorId)
+			seen[l.ActorId] = true
+		}
+		if resp.GetNextPaginationToken() == "" {
+			break
+		}
+		token = resp.NextPaginationToken
+	}
+
+	require.Len(t, seen, likers)
+}
+
+func TestListLikedYou(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *pb.ListLikedYouRequest
+		mockErr     error
+		wantErrCode codes.Code
+		wantLikers  int
+	}{
+		{
+			name:       "success",
+			req:        &pb.ListLikedYouRequest{RecipientUserId: testRecipientID},
+			wantLikers: 2,
+		},
+		{
+			name:        "repository error",
+			req:         &pb.ListLikedYouRequest{RecipientUserId: testRecipientID},
+			mockErr:     errors.New("db error"),
+			wantErrCode: codes.Unknown,
+		},
+		{
+			name:        "invalid recipient",
+			req:         &pb.ListLikedYouRequest{RecipientUserId: "not-a-uuid"},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name: "page size too large",
+			req: &pb.ListLikedYouRequest{
+				RecipientUserId: testRecipientID,
+				PageSize:        proto.Uint32(likedYouMaxPageSize + 1),
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name: "malformed pagination token",
+			req: &pb.ListLikedYouRequest{
+				RecipientUserId: testRecipientID,
+				PaginationToken: proto.String("Z2FyYmFnZQ=="),
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubStore()
+			ctx := context.Background()
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(1), testRecipientID, true))
+			require.NoError(t, repo.UpsertDecision(ctx, testActorID(2), testRecipientID, true))
+			repo.listLikedYouErr = tt.mockErr
+
+			s := NewExploreServiceServer(repo)
+			resp, err := s.ListLikedYou(ctx, tt.req)
+
+			if tt.wantErrCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantErrCode, st.Code(), "unexpected gRPC error code")
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Likers, tt.wantLikers)
+			require.Empty(t, resp.GetNextPaginationToken())
+		})
+	}
+}
+
+func TestListNewLikedYou_ExcludesLikedBack(t *testing.T) {
+	repo := newStubStore()
+	ctx := context.Background()
+
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(1), testRecipientID, true))
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(2), testRecipientID, true))
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(3), testRecipientID, true))
+	require.NoError(t, repo.UpsertDecision(ctx, testRecipientID, testActorID(1), true))
+	require.NoError(t, repo.UpsertDecision(ctx, testRecipientID, testActorID(2), false))
+
+	s := NewExploreServiceServer(repo)
+	resp, err := s.ListNewLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID})
+	require.NoError(t, err)
+
+	var got []string
+	for _, l := range resp.Likers {
+		got = append(got, l.ActorId)
+	}
+	require.Equal(t, []string{testActorID(3), testActorID(2)}, got)
+
+	repo.listNewLikedYouErr = errors.New("db error")
+	_, err = s.ListNewLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID})
+	require.Equal(t, codes.Unknown, status.Code(err))
+}
+
+func TestListLikedYou_OrderTokens(t *testing.T) {
+	repo := newStubStore()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, repo.UpsertDecision(ctx, testActorID(i), testRecipientID, true))
+	}
+	s := NewExploreServiceServer(repo)
+
+	recent := pb.LikedYouOrder_LIKED_YOU_ORDER_RECENT_ACTIVITY.Enum()
+	firstLiked := pb.LikedYouOrder_LIKED_YOU_ORDER_FIRST_LIKED.Enum()
+	legacyToken := base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(time.Now().Unix()+60, 10)))
+
+	// the first page defaults to first-liked order and its token remembers it
+	first, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID, PageSize: proto.Uint32(1)})
+	require.NoError(t, err)
+	cursor, err := pagination.Decode(first.GetNextPaginationToken())
+	require.NoError(t, err)
+	require.Equal(t, "first_liked", cursor.Order)
+
+	tests := []struct {
+		name  string
+		token string
+		order *pb.LikedYouOrder
+		want  codes.Code
+	}{
+		{name: "token keeps its order", token: first.GetNextPaginationToken(), want: codes.OK},
+		{name: "same order", token: first.GetNextPaginationToken(), order: firstLiked, want: codes.OK},
+		{name: "another order", token: first.GetNextPaginationToken(), order: recent, want: codes.InvalidArgument},
+		{name: "legacy token is recent activity", token: legacyToken, want: codes.OK},
+		{name: "legacy token with recent activity", token: legacyToken, order: recent, want: codes.OK},
+		{name: "legacy token with first liked", token: legacyToken, order: firstLiked, want: codes.InvalidArgument},
+		{
+			name:  "unknown token order",
+			token: pagination.Encode(pagination.Cursor{UnixTs: 1730000000, ID: testActorID(1), Order: "random"}),
+			want:  codes.InvalidArgument,
+		},
+		{name: "unknown order", order: pb.LikedYouOrder(99).Enum(), want: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.ListLikedYouRequest{RecipientUserId: testRecipientID, Order: tt.order}
+			if tt.token != "" {
+				req.PaginationToken = proto.String(tt.token)
+			}
+
+			resp, err := s.ListLikedYou(ctx, req)
+			require.Equal(t, tt.want, status.Code(err))
+			if tt.want == codes.OK {
+				require.NotEmpty(t, resp.Likers)
+			}
+
+			_, err = s.ListNewLikedYou(ctx, req)
+			require.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+func TestListLikedYou_TimeWindow(t *testing.T) {
+	repo := newStubStore()
+	ctx := context.Background()
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(1), testRecipientID, true))
+	require.NoError(t, repo.UpsertDecision(ctx, testActorID(2), testRecipientID, true))
+	s := NewExploreServiceServer(repo)
+
+	hourAgo := proto.Uint64(uint64(time.Now().Add(-time.Hour).Unix()))
+	inAnHour := proto.Uint64(uint64(time.Now().Add(time.Hour).Unix()))
+
+	tests := []struct {
+		name       string
+		since      *uint64
+		until      *uint64
+		wantLikers int
+	}{
+		{name: "inside the window", since: hourAgo, until: inAnHour, wantLikers: 2},
+		{name: "before the window", since: inAnHour, wantLikers: 0},
+		{name: "after the window", until: hourAgo, wantLikers: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.ListLikedYouRequest{
+				RecipientUserId:    testRecipientID,
+				SinceUnixTimestamp: tt.since,
+				UntilUnixTimestamp: tt.until,
+			}
+
+			resp, err := s.ListLikedYou(ctx, req)
+			require.NoError(t, err)
+			require.Len(t, resp.Likers, tt.wantLikers)
+
+			resp, err = s.ListNewLikedYou(ctx, req)
+			require.NoError(t, err)
+			require.Len(t, resp.Likers, tt.wantLikers)
+		})
+	}
+
+	_, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID, SinceUnixTimestamp: proto.Uint64(0)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
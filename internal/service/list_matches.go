@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) ListMatches(ctx context.Context, req *pb.ListMatchesRequest) (*pb.ListMatchesResponse, error) {
+	err := validateListMatchesRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = matchesDefaultPageSize
+	}
+
+	cursor, err := pagination.Decode(req.GetPaginationToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
+	}
+
+	matches, err := s.Repo.ListMatches(ctx, req.UserId, cursor, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "ListMatches() error: %v", err)
+	}
+
+	var nextToken string
+
+	// check if next page is needed
+	if len(matches) > pageSize {
+		last := matches[pageSize-1]
+		nextToken = pagination.Encode(pagination.Cursor{UnixTs: last.MatchedAtUnix, ID: last.ID})
+		matches = matches[:pageSize]
+	}
+
+	pbMatches := make([]*pb.Match, 0, len(matches))
+	for _, m := range matches {
+		pbMatches = append(pbMatches, toPBMatch(m))
+	}
+
+	return &pb.ListMatchesResponse{
+		Matches:             pbMatches,
+		NextPaginationToken: &nextToken,
+	}, nil
+}
+
+func toPBMatch(m dataaccess.Match) *pb.Match {
+	return &pb.Match{
+		MatchId:              m.ID,
+		UserId:               m.UserID,
+		MatchedUnixTimestamp: uint64(m.MatchedAtUnix),
+	}
+}
+
+func validateListMatchesRequest(req *pb.ListMatchesRequest) error {
+	errList := []string{}
+
+	userID := req.GetUserId()
+	if userID == "" {
+		errList = append(errList, "user_id is required")
+	}
+	if !uuidRegex.MatchString(userID) {
+		errList = append(errList, "user_id must be a valid UUID")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize > matchesMaxPageSize {
+		errList = append(errList, fmt.Sprintf("page_size cannot exceed %v", matchesMaxPageSize))
+	}
+
+	paginationToken := req.GetPaginationToken()
+	if paginationToken != "" {
+		if _, err := base64.StdEncoding.DecodeString(paginationToken); err != nil {
+			errList = append(errList, "pagination_token must be valid base64")
+		}
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
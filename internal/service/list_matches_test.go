@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPutDecision_CreatesMatchOnce(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	like := func(actor, recipient string) *pb.PutDecisionResponse {
+		resp, err := s.PutDecision(ctx, &pb.PutDecisionRequest{
+			ActorUserId:     actor,
+			RecipientUserId: recipient,
+			LikedRecipient:  true,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	require.Nil(t, like(testActorID(1), testRecipientID).Match)
+
+	resp := like(testRecipientID, testActorID(1))
+	require.True(t, resp.MutualLikes)
+	require.NotNil(t, resp.Match)
+	require.NotEmpty(t, resp.Match.MatchId)
+	require.Equal(t, testActorID(1), resp.Match.UserId)
+	require.NotZero(t, resp.Match.MatchedUnixTimestamp)
+
+	// liking again keeps the existing match
+	again := like(testRecipientID, testActorID(1))
+	require.True(t, again.MutualLikes)
+	require.Nil(t, again.Match)
+
+	for _, tc := range []struct{ user, other string }{
+		{testRecipientID, testActorID(1)},
+		{testActorID(1), testRecipientID},
+	} {
+		list, err := s.ListMatches(ctx, &pb.ListMatchesRequest{UserId: tc.user})
+		require.NoError(t, err)
+		require.Len(t, list.Matches, 1)
+		require.Equal(t, resp.Match.MatchId, list.Matches[0].MatchId)
+		require.Equal(t, tc.other, list.Matches[0].UserId)
+	}
+}
+
+func TestListMatches_Pagination(t *testing.T) {
+	repo := newStubStore()
+	s := NewExploreServiceServer(repo)
+	ctx := context.Background()
+
+	const matches = 7
+	for i := 0; i < matches; i++ {
+		_, err := repo.RecordDecision(ctx, testActorID(i), testRecipientID, true)
+		require.NoError(t, err)
+		_, err = repo.RecordDecision(ctx, testRecipientID, testActorID(i), true)
+		require.NoError(t, err)
+	}
+
+	seen := map[string]bool{}
+	var token *string
+	for pages := 0; pages < matches; pages++ {
+		resp, err := s.ListMatches(ctx, &pb.ListMatchesRequest{
+			UserId:          testRecipientID,
+			PaginationToken: token,
+			PageSize:        proto.Uint32(3),
+		})
+		require.NoError(t, err)
+		for _, m := range resp.Matches {
+			require.False(t, seen[m.MatchId], "match %s returned twice", m.MatchId)
+			seen[m.MatchId] = true
+		}
+		if resp.GetNextPaginationToken() == "" {
+			break
+		}
+		token = resp.NextPaginationToken
+	}
+	require.Len(t, seen, matches)
+}
+
+func TestListMatches_Errors(t *testing.T) {
+	repo := newStubStore()
+	repo.listMatchesErr = errors.New("db error")
+	s := NewExploreServiceServer(repo)
+
+	_, err := s.ListMatches(context.Background(), &pb.ListMatchesRequest{UserId: "not-a-uuid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListMatches(context.Background(), &pb.ListMatchesRequest{
+		UserId:   testRecipientID,
+		PageSize: proto.Uint32(matchesMaxPageSize + 1),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListMatches(context.Background(), &pb.ListMatchesRequest{UserId: testRecipientID})
+	require.Equal(t, codes.Unknown, status.Code(err))
+}
@@ -20,34 +20,20 @@ func (s *ExploreServiceServer) ListNewLikedYou(ctx context.Context, req *pb.List
 		pageSize = likedYouDefaultPageSize
 	}
 
-	paginationUnix, err := pagination.Decode(req.GetPaginationToken())
+	cursor, err := pagination.Decode(req.GetPaginationToken())
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "Decode() error: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
 	}
 
-	decisions, err := s.Repo.ListNewLikedYou(ctx, req.RecipientUserId, paginationUnix, pageSize)
+	order, err := likedYouOrder(req, cursor)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "ListLikedYou() error: %v", err)
-	}
-
-	var likers []*pb.ListLikedYouResponse_Liker
-	var nextToken string
-
-	// check if next page is needed
-	if len(decisions) > pageSize {
-		nextToken = pagination.Encode(decisions[pageSize-1].UpdatedAtUnix)
-		decisions = decisions[:pageSize]
+		return nil, err
 	}
 
-	for _, d := range decisions {
-		likers = append(likers, &pb.ListLikedYouResponse_Liker{
-			ActorId:       d.ActorID,
-			UnixTimestamp: uint64(d.UpdatedAtUnix),
-		})
+	decisions, err := s.Repo.ListNewLikedYou(ctx, req.RecipientUserId, order, toTimeWindow(req.SinceUnixTimestamp, req.UntilUnixTimestamp), cursor, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "ListLikedYou() error: %v", err)
 	}
 
-	return &pb.ListLikedYouResponse{
-		Likers:              likers,
-		NextPaginationToken: &nextToken,
-	}, nil
+	return s.likedYouResponse(ctx, req.RecipientUserId, order, decisions, pageSize)
 }
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListYouLiked pages like ListLikedYou, with the same page size limits.
+func (s *ExploreServiceServer) ListYouLiked(ctx context.Context, req *pb.ListYouLikedRequest) (*pb.ListYouLikedResponse, error) {
+	err := validateListYouLikedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = likedYouDefaultPageSize
+	}
+
+	cursor, err := pagination.Decode(req.GetPaginationToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
+	}
+
+	likes, err := s.Repo.ListYouLiked(ctx, req.ActorUserId, toYouLikedFilter(req.Filter), cursor, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "ListYouLiked() error: %v", err)
+	}
+
+	var nextToken string
+
+	// check if next page is needed
+	if len(likes) > pageSize {
+		last := likes[pageSize-1]
+		nextToken = pagination.Encode(pagination.Cursor{UnixTs: last.UpdatedAtUnix, ID: last.RecipientID})
+		likes = likes[:pageSize]
+	}
+
+	likees := make([]*pb.ListYouLikedResponse_Likee, 0, len(likes))
+	for _, l := range likes {
+		likees = append(likees, &pb.ListYouLikedResponse_Likee{
+			RecipientId:   l.RecipientID,
+			UnixTimestamp: uint64(l.UpdatedAtUnix),
+			Matched:       l.Matched,
+		})
+	}
+
+	return &pb.ListYouLikedResponse{
+		Likees:              likees,
+		NextPaginationToken: &nextToken,
+	}, nil
+}
+
+func (s *ExploreServiceServer) CountYouLiked(ctx context.Context, req *pb.CountYouLikedRequest) (*pb.CountYouLikedResponse, error) {
+	err := validateCountYouLikedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.Repo.CountYouLiked(ctx, req.ActorUserId, toYouLikedFilter(req.Filter))
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "CountYouLiked() error: %v", err)
+	}
+
+	return &pb.CountYouLikedResponse{
+		Count: count,
+	}, nil
+}
+
+func toYouLikedFilter(filter pb.YouLikedFilter) dataaccess.YouLikedFilter {
+	switch filter {
+	case pb.YouLikedFilter_YOU_LIKED_FILTER_PENDING:
+		return dataaccess.YouLikedPending
+	case pb.YouLikedFilter_YOU_LIKED_FILTER_MATCHED:
+		return dataaccess.YouLikedMatched
+	default:
+		return dataaccess.YouLikedAll
+	}
+}
+
+// validateYouLikedFilter rejects enum values this server doesn't know about
+// instead of silently listing everything.
+func validateYouLikedFilter(filter pb.YouLikedFilter) []string {
+	if _, ok := pb.YouLikedFilter_name[int32(filter)]; !ok {
+		return []string{fmt.Sprintf("filter %d is not supported", filter)}
+	}
+	return nil
+}
+
+func validateListYouLikedRequest(req *pb.ListYouLikedRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize > likedYouMaxPageSize {
+		errList = append(errList, fmt.Sprintf("page_size cannot exceed %v", likedYouMaxPageSize))
+	}
+
+	paginationToken := req.GetPaginationToken()
+	if paginationToken != "" {
+		if _, err := base64.StdEncoding.DecodeString(paginationToken); err != nil {
+			errList = append(errList, "pagination_token must be valid base64")
+		}
+	}
+
+	errList = append(errList, validateYouLikedFilter(req.GetFilter())...)
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
+
+func validateCountYouLikedRequest(req *pb.CountYouLikedRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	errList = append(errList, validateYouLikedFilter(req.GetFilter())...)
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
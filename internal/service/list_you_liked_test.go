@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListYouLiked_Filters(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	put := func(actor, recipient string, liked bool) {
+		_, err := s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: actor, RecipientUserId: recipient, LikedRecipient: liked})
+		require.NoError(t, err)
+	}
+
+	actor := testRecipientID
+	for i := 0; i < 6; i++ {
+		put(actor, testActorID(i), true)
+	}
+	put(actor, testActorID(6), false)
+	put(testActorID(0), actor, true) // match
+	put(testActorID(1), actor, true) // match
+	_, err := s.BlockUser(ctx, &pb.BlockUserRequest{ActorUserId: actor, BlockedUserId: testActorID(2)})
+	require.NoError(t, err)
+	// a block by the recipient must not be visible to the actor
+	_, err = s.BlockUser(ctx, &pb.BlockUserRequest{ActorUserId: testActorID(3), BlockedUserId: actor})
+	require.NoError(t, err)
+
+	list := func(filter pb.YouLikedFilter) map[string]bool {
+		t.Helper()
+		got := map[string]bool{}
+		var token *string
+		for {
+			resp, err := s.ListYouLiked(ctx, &pb.ListYouLikedRequest{
+				ActorUserId:     actor,
+				PaginationToken: token,
+				PageSize:        proto.Uint32(2),
+				Filter:          filter,
+			})
+			require.NoError(t, err)
+			for _, l := range resp.Likees {
+				got[l.RecipientId] = l.Matched
+			}
+			if resp.GetNextPaginationToken() == "" {
+				break
+			}
+			token = resp.NextPaginationToken
+		}
+
+		count, err := s.CountYouLiked(ctx, &pb.CountYouLikedRequest{ActorUserId: actor, Filter: filter})
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(got)), count.Count)
+		return got
+	}
+
+	require.Equal(t, map[string]bool{
+		testActorID(0): true,
+		testActorID(1): true,
+		testActorID(3): false,
+		testActorID(4): false,
+		testActorID(5): false,
+	}, list(pb.YouLikedFilter_YOU_LIKED_FILTER_ALL))
+	require.Equal(t, map[string]bool{
+		testActorID(3): false,
+		testActorID(4): false,
+		testActorID(5): false,
+	}, list(pb.YouLikedFilter_YOU_LIKED_FILTER_PENDING))
+	require.Equal(t, map[string]bool{
+		testActorID(0): true,
+		testActorID(1): true,
+	}, list(pb.YouLikedFilter_YOU_LIKED_FILTER_MATCHED))
+
+	// an ended match is hidden, as are deactivated recipients
+	_, err = s.Unmatch(ctx, &pb.UnmatchRequest{ActorUserId: testActorID(0), OtherUserId: actor})
+	require.NoError(t, err)
+	_, err = s.DeactivateUser(ctx, &pb.DeactivateUserRequest{UserId: testActorID(4)})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{
+		testActorID(1): true,
+		testActorID(3): false,
+		testActorID(5): false,
+	}, list(pb.YouLikedFilter_YOU_LIKED_FILTER_ALL))
+}
+
+func TestListYouLiked_Validation(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	_, err := s.ListYouLiked(ctx, &pb.ListYouLikedRequest{ActorUserId: "bad", PageSize: proto.Uint32(likedYouMaxPageSize + 1)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.CountYouLiked(ctx, &pb.CountYouLikedRequest{ActorUserId: testActorID(0), Filter: pb.YouLikedFilter(42)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
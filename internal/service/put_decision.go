@@ -4,44 +4,41 @@ import (
 	"context"
 	"strings"
 
-	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
 	pb "github.com/jacob-alt-del/explore-service/internal/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// allow stubbing data access repo for unit testing
-var (
-	fnUpsertDecision = func(repo *dataaccess.Repository, ctx context.Context, actorID, recipientID string, liked bool) error {
-		return repo.UpsertDecision(ctx, actorID, recipientID, liked)
-	}
-	fnCheckMutualLike = func(repo *dataaccess.Repository, ctx context.Context, actorID, recipientID string) (bool, error) {
-		return repo.CheckMutualLike(ctx, actorID, recipientID)
-	}
-)
-
 func (s *ExploreServiceServer) PutDecision(ctx context.Context, req *pb.PutDecisionRequest) (*pb.PutDecisionResponse, error) {
 	err := ValidatePutDecisionRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	err = fnUpsertDecision(s.Repo, ctx, req.ActorUserId, req.RecipientUserId, req.LikedRecipient)
+	if req.LikedRecipient && s.Quota != nil {
+		usage, err := s.Quota.Usage(ctx, req.ActorUserId)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "Usage() error: %v", err)
+		}
+		if !usage.Take(req.RecipientUserId) {
+			return nil, likeQuotaError(usage)
+		}
+	}
+
+	result, err := s.Repo.RecordDecision(ctx, req.ActorUserId, req.RecipientUserId, req.LikedRecipient)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "UpsertDecision() error: %v", err)
+		return nil, userError("RecordDecision", err)
 	}
+	s.publishDecision(ctx, req.ActorUserId, req.RecipientUserId, result)
 
-	mutualLike := false
-	if req.LikedRecipient {
-		mutualLike, err = fnCheckMutualLike(s.Repo, ctx, req.ActorUserId, req.RecipientUserId)
-		if err != nil {
-			return nil, status.Errorf(codes.Unknown, "CheckMutualLike() error: %v", err)
-		}
+	resp := &pb.PutDecisionResponse{
+		MutualLikes: result.MutualLike,
+	}
+	if result.Match != nil {
+		resp.Match = toPBMatch(*result.Match)
 	}
 
-	return &pb.PutDecisionResponse{
-		MutualLikes: mutualLike,
-	}, nil
+	return resp, nil
 }
 
 func ValidatePutDecisionRequest(req *pb.PutDecisionRequest) error {
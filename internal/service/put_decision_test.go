@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
@@ -14,23 +15,14 @@ import (
 )
 
 func TestPutDecision(t *testing.T) {
-	// Keep originals so we can restore them after
-	origUpsert := fnUpsertDecision
-	origCheck := fnCheckMutualLike
-	defer func() {
-		fnUpsertDecision = origUpsert
-		fnCheckMutualLike = origCheck
-	}()
-
-	validUUID1 := "550e8400-e29b-41d4-a716-446655440000"
-	validUUID2 := "123e4567-e89b-12d3-a456-426614174000"
+	validUUID1 := testActorID(0)
+	validUUID2 := testRecipientID
 
 	tests := []struct {
 		name           string
 		req            *pb.PutDecisionRequest
-		mockUpsertErr  error
-		mockCheckLike  bool
-		mockCheckErr   error
+		seed           func(repo dataaccess.Store)
+		mockRecordErr  error
 		wantErrCode    codes.Code
 		wantMutualLike bool
 	}{
@@ -41,7 +33,9 @@ func TestPutDecision(t *testing.T) {
 				RecipientUserId: validUUID2,
 				LikedRecipient:  true,
 			},
-			mockCheckLike:  true,
+			seed: func(repo dataaccess.Store) {
+				_ = repo.UpsertDecision(context.Background(), validUUID2, validUUID1, true)
+			},
 			wantMutualLike: true,
 		},
 		{
@@ -51,28 +45,38 @@ func TestPutDecision(t *testing.T) {
 				RecipientUserId: validUUID2,
 				LikedRecipient:  true,
 			},
-			mockCheckLike:  false,
 			wantMutualLike: false,
 		},
 		{
-			name: "UpsertDecision returns error",
+			name: "pass never reports a mutual like",
+			req: &pb.PutDecisionRequest{
+				ActorUserId:     validUUID1,
+				RecipientUserId: validUUID2,
+				LikedRecipient:  false,
+			},
+			seed: func(repo dataaccess.Store) {
+				_ = repo.UpsertDecision(context.Background(), validUUID2, validUUID1, true)
+			},
+			wantMutualLike: false,
+		},
+		{
+			name: "RecordDecision returns error",
 			req: &pb.PutDecisionRequest{
 				ActorUserId:     validUUID1,
 				RecipientUserId: validUUID2,
 				LikedRecipient:  true,
 			},
-			mockUpsertErr: errors.New("db error"),
+			mockRecordErr: errors.New("db error"),
 			wantErrCode:   codes.Unknown,
 		},
 		{
-			name: "CheckMutualLike returns error",
+			name: "unknown recipient",
 			req: &pb.PutDecisionRequest{
 				ActorUserId:     validUUID1,
-				RecipientUserId: validUUID2,
+				RecipientUserId: "00000000-0000-0000-0000-000000000000",
 				LikedRecipient:  true,
 			},
-			mockCheckErr: errors.New("check error"),
-			wantErrCode:  codes.Unknown,
+			wantErrCode: codes.NotFound,
 		},
 		{
 			name: "invalid request (missing actor ID)",
@@ -81,21 +85,19 @@ func TestPutDecision(t *testing.T) {
 				RecipientUserId: validUUID2,
 				LikedRecipient:  true,
 			},
-			wantErrCode: codes.InvalidArgument, // assuming your ValidatePutDecisionRequest returns this
+			wantErrCode: codes.InvalidArgument,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Override the function variables for test
-			fnUpsertDecision = func(repo *dataaccess.Repository, ctx context.Context, actorID, recipientID string, liked bool) error {
-				return tt.mockUpsertErr
-			}
-			fnCheckMutualLike = func(repo *dataaccess.Repository, ctx context.Context, actorID, recipientID string) (bool, error) {
-				return tt.mockCheckLike, tt.mockCheckErr
+			repo := newStubStore()
+			if tt.seed != nil {
+				tt.seed(repo)
 			}
+			repo.recordDecisionErr = tt.mockRecordErr
 
-			s := &ExploreServiceServer{Repo: &dataaccess.Repository{}}
+			s := NewExploreServiceServer(repo)
 
 			resp, err := s.PutDecision(context.Background(), tt.req)
 
@@ -115,6 +117,34 @@ func TestPutDecision(t *testing.T) {
 	}
 }
 
+func TestPutDecision_ConcurrentMutualLikeReportedOnce(t *testing.T) {
+	userA := testActorID(0)
+	userB := testRecipientID
+
+	for i := 0; i < 20; i++ {
+		s := NewExploreServiceServer(newStubStore())
+
+		var wg sync.WaitGroup
+		results := make([]bool, 2)
+		for j, pair := range [][2]string{{userA, userB}, {userB, userA}} {
+			wg.Add(1)
+			go func(j int, actor, recipient string) {
+				defer wg.Done()
+				resp, err := s.PutDecision(context.Background(), &pb.PutDecisionRequest{
+					ActorUserId:     actor,
+					RecipientUserId: recipient,
+					LikedRecipient:  true,
+				})
+				require.NoError(t, err)
+				results[j] = resp.MutualLikes
+			}(j, pair[0], pair[1])
+		}
+		wg.Wait()
+
+		require.True(t, results[0] != results[1], "exactly one request must report the match, got %v", results)
+	}
+}
+
 func Test_validatePutDecisionRequest(t *testing.T) {
 	validUUID1 := "550e8400-e29b-41d4-a716-446655440000"
 	validUUID2 := "123e4567-e89b-12d3-a456-426614174000"
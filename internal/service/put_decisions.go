@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/quota"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) PutDecisions(ctx context.Context, req *pb.PutDecisionsRequest) (*pb.PutDecisionsResponse, error) {
+	err := validatePutDecisionsRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage *quota.Usage
+	if s.Quota != nil && slices.ContainsFunc(req.Decisions, (*pb.PutDecisionsRequest_Decision).GetLikedRecipient) {
+		u, err := s.Quota.Usage(ctx, req.ActorUserId)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "Usage() error: %v", err)
+		}
+		usage = &u
+	}
+
+	results := make([]*pb.PutDecisionsResponse_Result, len(req.Decisions))
+	var inputs []dataaccess.DecisionInput
+	var inputIdx []int
+	seen := map[string]bool{}
+
+	// invalid and over quota decisions are reported per item, the rest are still recorded
+	for i, d := range req.Decisions {
+		results[i] = &pb.PutDecisionsResponse_Result{RecipientUserId: d.GetRecipientUserId()}
+
+		err := ValidatePutDecisionRequest(&pb.PutDecisionRequest{
+			ActorUserId:     req.ActorUserId,
+			RecipientUserId: d.GetRecipientUserId(),
+			LikedRecipient:  d.GetLikedRecipient(),
+		})
+		if err != nil {
+			msg := status.Convert(err).Message()
+			results[i].Error = &msg
+			continue
+		}
+		if seen[d.RecipientUserId] {
+			msg := "recipient_user_id appears more than once in the batch"
+			results[i].Error = &msg
+			continue
+		}
+		seen[d.RecipientUserId] = true
+		if d.LikedRecipient && usage != nil && !usage.Take(d.RecipientUserId) {
+			msg := likeQuotaExceededMessage
+			results[i].Error = &msg
+			continue
+		}
+
+		inputs = append(inputs, dataaccess.DecisionInput{RecipientID: d.RecipientUserId, Liked: d.LikedRecipient})
+		inputIdx = append(inputIdx, i)
+	}
+
+	recorded, err := s.Repo.RecordDecisions(ctx, req.ActorUserId, inputs)
+	if err != nil {
+		return nil, userError("RecordDecisions", err)
+	}
+
+	for j, r := range recorded {
+		s.publishDecision(ctx, req.ActorUserId, inputs[j].RecipientID, r)
+
+		result := results[inputIdx[j]]
+		result.MutualLikes = r.MutualLike
+		if r.Match != nil {
+			result.Match = toPBMatch(*r.Match)
+		}
+	}
+
+	return &pb.PutDecisionsResponse{
+		Results: results,
+	}, nil
+}
+
+func validatePutDecisionsRequest(req *pb.PutDecisionsRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	decisions := req.GetDecisions()
+	if len(decisions) == 0 {
+		errList = append(errList, "decisions is required")
+	}
+	if len(decisions) > putDecisionsMaxBatchSize {
+		errList = append(errList, fmt.Sprintf("decisions cannot exceed %v items", putDecisionsMaxBatchSize))
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPutDecisions(t *testing.T) {
+	repo := newStubStore()
+	s := NewExploreServiceServer(repo)
+	ctx := context.Background()
+	actor := testRecipientID
+
+	// testActorID(2) already likes the actor, so liking back matches
+	_, err := repo.RecordDecision(ctx, testActorID(2), actor, true)
+	require.NoError(t, err)
+
+	resp, err := s.PutDecisions(ctx, &pb.PutDecisionsRequest{
+		ActorUserId: actor,
+		Decisions: []*pb.PutDecisionsRequest_Decision{
+			{RecipientUserId: testActorID(1), LikedRecipient: true},
+			{RecipientUserId: testActorID(2), LikedRecipient: true},
+			{RecipientUserId: "not-a-uuid", LikedRecipient: true},
+			{RecipientUserId: actor, LikedRecipient: true},
+			{RecipientUserId: testActorID(3), LikedRecipient: false},
+			{RecipientUserId: testActorID(1), LikedRecipient: false},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 6)
+
+	require.Nil(t, resp.Results[0].Error)
+	require.False(t, resp.Results[0].MutualLikes)
+
+	require.Nil(t, resp.Results[1].Error)
+	require.True(t, resp.Results[1].MutualLikes)
+	require.NotNil(t, resp.Results[1].Match)
+	require.Equal(t, testActorID(2), resp.Results[1].Match.UserId)
+
+	require.Contains(t, resp.Results[2].GetError(), "recipient_user_id must be a valid UUID")
+	require.Contains(t, resp.Results[3].GetError(), "recipient_user_id must not equal actor_user_id")
+
+	require.Nil(t, resp.Results[4].Error)
+	require.Contains(t, resp.Results[5].GetError(), "more than once")
+
+	// only the valid decisions were recorded, and the duplicate did not override the first
+	count, err := repo.CountLikedYou(ctx, testActorID(1), dataaccess.TimeWindow{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+}
+
+func TestPutDecisions_Errors(t *testing.T) {
+	tooMany := make([]*pb.PutDecisionsRequest_Decision, putDecisionsMaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = &pb.PutDecisionsRequest_Decision{RecipientUserId: testActorID(i)}
+	}
+
+	tests := []struct {
+		name        string
+		req         *pb.PutDecisionsRequest
+		mockErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name:        "invalid actor",
+			req:         &pb.PutDecisionsRequest{ActorUserId: "nope", Decisions: tooMany[:1]},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:        "empty batch",
+			req:         &pb.PutDecisionsRequest{ActorUserId: testRecipientID},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:        "batch too large",
+			req:         &pb.PutDecisionsRequest{ActorUserId: testRecipientID, Decisions: tooMany},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:        "repository error",
+			req:         &pb.PutDecisionsRequest{ActorUserId: testRecipientID, Decisions: tooMany[:2]},
+			mockErr:     errors.New("db error"),
+			wantErrCode: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubStore()
+			repo.recordDecisionsErr = tt.mockErr
+			s := NewExploreServiceServer(repo)
+
+			resp, err := s.PutDecisions(context.Background(), tt.req)
+			require.Nil(t, resp)
+			require.Equal(t, tt.wantErrCode, status.Code(err))
+		})
+	}
+}
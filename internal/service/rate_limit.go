@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DefaultRateLimits returns the per actor limits of each RPC, keyed by RPC
+// name. PutDecisions takes a token per decision so its burst must fit a full
+// batch.
+func DefaultRateLimits() map[string]ratelimit.Limit {
+	return map[string]ratelimit.Limit{
+		"PutDecision":  ratelimit.Every(120, time.Minute, 60),
+		"PutDecisions": ratelimit.Every(600, time.Minute, putDecisionsMaxBatchSize),
+	}
+}
+
+// RateLimiter limits how often each actor_user_id can call an RPC, with a
+// token bucket per RPC and actor. RPCs without a limit, and requests the
+// handler rejects as invalid, are not limited.
+type RateLimiter struct {
+	store  ratelimit.Store
+	limits map[string]ratelimit.Limit
+}
+
+// NewRateLimiter returns a limiter enforcing the limits, keyed by RPC name
+// such as PutDecision.
+func NewRateLimiter(store ratelimit.Store, limits map[string]ratelimit.Limit) *RateLimiter {
+	return &RateLimiter{store: store, limits: limits}
+}
+
+type actorRequest interface {
+	GetActorUserId() string
+}
+
+// Unary is the grpc.UnaryServerInterceptor. Rejected requests fail with
+// ResourceExhausted carrying a RetryInfo detail. If the store fails the
+// request is let through, an outage of the limiter shouldn't take down swiping.
+func (l *RateLimiter) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := path.Base(info.FullMethod)
+	limit, ok := l.limits[method]
+	if !ok {
+		return handler(ctx, req)
+	}
+	// invalid actors are left to the handler's validation rather than
+	// getting a bucket of their own
+	r, ok := req.(actorRequest)
+	if !ok || !uuidRegex.MatchString(r.GetActorUserId()) {
+		return handler(ctx, req)
+	}
+	cost := requestCost(req)
+	if cost == 0 {
+		return handler(ctx, req)
+	}
+
+	result, err := l.store.Take(ctx, method+":"+r.GetActorUserId(), limit, cost)
+	if err != nil {
+		log.Printf("failed to check the %s rate limit of %s: %v", method, r.GetActorUserId(), err)
+		return handler(ctx, req)
+	}
+	if !result.Allowed {
+		return nil, rateLimitError(method, cost, limit, result.RetryAfter)
+	}
+
+	return handler(ctx, req)
+}
+
+// requestCost is how many tokens the request takes, one per decision the
+// handler would record. Invalid and repeated decisions cost nothing, and
+// neither do batches over the size limit so they fail validation instead.
+func requestCost(req any) int {
+	switch r := req.(type) {
+	case *pb.PutDecisionRequest:
+		if ValidatePutDecisionRequest(r) != nil {
+			return 0
+		}
+	case *pb.PutDecisionsRequest:
+		if len(r.Decisions) > putDecisionsMaxBatchSize {
+			return 0
+		}
+		cost := 0
+		seen := map[string]bool{}
+		for _, d := range r.Decisions {
+			err := ValidatePutDecisionRequest(&pb.PutDecisionRequest{
+				ActorUserId:     r.ActorUserId,
+				RecipientUserId: d.GetRecipientUserId(),
+			})
+			if err != nil || seen[d.GetRecipientUserId()] {
+				continue
+			}
+			seen[d.GetRecipientUserId()] = true
+			cost++
+		}
+		return cost
+	}
+	return 1
+}
+
+func rateLimitError(method string, cost int, limit ratelimit.Limit, retryAfter time.Duration) error {
+	if retryAfter == 0 {
+		return status.Errorf(codes.ResourceExhausted, "%s rate limit exceeded: request needs %v tokens, the limit allows at most %v at once", method, cost, limit.Burst)
+	}
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("%s rate limit exceeded for actor_user_id, retry in %v", method, retryAfter.Round(time.Millisecond)))
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/ratelimit"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingLimitStore struct{}
+
+func (failingLimitStore) Take(context.Context, string, ratelimit.Limit, int) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("store unavailable")
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewRateLimiter(ratelimit.NewMemoryStore(), map[string]ratelimit.Limit{
+		"PutDecision":  ratelimit.Every(1, time.Minute, 2),
+		"PutDecisions": ratelimit.Every(1, time.Minute, 3),
+	})
+
+	calls := 0
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		return nil, nil
+	}
+	call := func(method string, req any) error {
+		_, err := limiter.Unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/explore.ExploreService/" + method}, handler)
+		return err
+	}
+	putDecision := func(actorID string) error {
+		return call("PutDecision", &pb.PutDecisionRequest{ActorUserId: actorID, RecipientUserId: testRecipientID, LikedRecipient: true})
+	}
+
+	require.NoError(t, putDecision(testActorID(1)))
+	require.NoError(t, putDecision(testActorID(1)))
+
+	err := putDecision(testActorID(1))
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.InDelta(t, time.Minute, retryInfo.RetryDelay.AsDuration(), float64(time.Second))
+	require.Equal(t, 2, calls)
+
+	// every actor has their own bucket
+	require.NoError(t, putDecision(testActorID(2)))
+
+	// RPCs without a limit and requests without a valid actor aren't limited
+	for i := 0; i < 5; i++ {
+		require.NoError(t, call("GetDecision", &pb.GetDecisionRequest{ActorUserId: testActorID(1), RecipientUserId: testRecipientID}))
+		require.NoError(t, putDecision(""))
+		require.NoError(t, putDecision("not-a-uuid"))
+	}
+
+	// nor are requests the handler rejects as invalid
+	for i := 0; i < 5; i++ {
+		require.NoError(t, call("PutDecision", &pb.PutDecisionRequest{ActorUserId: testActorID(2), RecipientUserId: testActorID(2)}))
+	}
+	require.NoError(t, putDecision(testActorID(2)))
+
+	// batches take a token per valid decision
+	batch := func(actorID string, recipientIDs ...string) *pb.PutDecisionsRequest {
+		req := &pb.PutDecisionsRequest{ActorUserId: actorID}
+		for _, id := range recipientIDs {
+			req.Decisions = append(req.Decisions, &pb.PutDecisionsRequest_Decision{RecipientUserId: id, LikedRecipient: true})
+		}
+		return req
+	}
+	require.NoError(t, call("PutDecisions", batch(testActorID(1), testActorID(2), testActorID(3))))
+	st = status.Convert(call("PutDecisions", batch(testActorID(1), testActorID(2), testActorID(3))))
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	// invalid, repeated and self decisions are free
+	require.NoError(t, call("PutDecisions", batch(testActorID(1), testActorID(2), testActorID(2), "not-a-uuid", testActorID(1))))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("PutDecisions", batch(testActorID(1), testActorID(2)))))
+	require.NoError(t, call("PutDecisions", batch(testActorID(1), "not-a-uuid")))
+
+	// a batch with more valid decisions than the burst never fits
+	st = status.Convert(call("PutDecisions", batch(testActorID(4), testActorID(1), testActorID(2), testActorID(3), testActorID(5))))
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Empty(t, st.Details())
+	require.NoError(t, call("PutDecisions", batch(testActorID(4), testActorID(1), testActorID(2), testActorID(3))))
+
+	// a batch over the size limit gets the handler's validation error
+	s := NewExploreServiceServer(newStubStore())
+	recipients := make([]string, putDecisionsMaxBatchSize+1)
+	for i := range recipients {
+		recipients[i] = testActorID(i % testUsers)
+	}
+	_, err = limiter.Unary(ctx, batch(testActorID(4), recipients...), &grpc.UnaryServerInfo{FullMethod: "/explore.ExploreService/PutDecisions"},
+		func(ctx context.Context, req any) (any, error) {
+			return s.PutDecisions(ctx, req.(*pb.PutDecisionsRequest))
+		})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// requests are let through when the store fails
+	limiter = NewRateLimiter(failingLimitStore{}, DefaultRateLimits())
+	require.NoError(t, putDecision(testActorID(1)))
+}
@@ -2,14 +2,26 @@ package service
 
 import (
 	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/events"
 	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/jacob-alt-del/explore-service/internal/quota"
 )
 
 type ExploreServiceServer struct {
 	pb.UnimplementedExploreServiceServer
-	Repo *dataaccess.Repository
+	Repo dataaccess.Store
+	// Events carries new likes and matches from PutDecision(s) to WatchLikedYou.
+	Events *events.Bus
+	// Quota caps the daily likes of each actor, nil leaves likes unlimited.
+	Quota *quota.Checker
 }
 
-func NewExploreServiceServer(db *dataaccess.Repository) *ExploreServiceServer {
-	return &ExploreServiceServer{Repo: db}
+// NewExploreServiceServer returns a server whose events only reach watchers
+// connected to the same replica, replace Events with a bus over a shared
+// Broadcaster when running several.
+func NewExploreServiceServer(repo dataaccess.Store) *ExploreServiceServer {
+	return &ExploreServiceServer{
+		Repo:   repo,
+		Events: events.NewBus(events.NewMemoryBroadcaster(), events.DefaultBufferSize, events.DefaultHistorySize),
+	}
 }
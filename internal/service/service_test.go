@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+)
+
+const (
+	testRecipientID = "123e4567-e89b-12d3-a456-426614174000"
+	testUsers       = 100
+)
+
+func testActorID(i int) string {
+	return fmt.Sprintf("550e8400-e29b-41d4-a716-%012d", i)
+}
+
+// stubStore is an in-memory store that can be told to fail individual calls.
+type stubStore struct {
+	dataaccess.Store
+
+	listLikedYouErr    error
+	listNewLikedYouErr error
+	countLikedYouErr   error
+	recordDecisionErr  error
+	recordDecisionsErr error
+	listMatchesErr     error
+}
+
+// newStubStore returns a stub over an in-memory store that already holds
+// testRecipientID and the users testActorID(0) to testActorID(testUsers-1).
+func newStubStore() *stubStore {
+	repo := dataaccess.NewMemoryRepository()
+	ctx := context.Background()
+	_, _ = repo.CreateUser(ctx, testRecipientID, "recipient")
+	for i := 0; i < testUsers; i++ {
+		_, _ = repo.CreateUser(ctx, testActorID(i), fmt.Sprintf("actor-%d", i))
+	}
+	return &stubStore{Store: repo}
+}
+
+func (s *stubStore) ListLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error) {
+	if s.listLikedYouErr != nil {
+		return nil, s.listLikedYouErr
+	}
+	return s.Store.ListLikedYou(ctx, recipientID, order, window, cursor, pageSize)
+}
+
+func (s *stubStore) ListNewLikedYou(
+	ctx context.Context,
+	recipientID string,
+	order dataaccess.LikedYouOrder,
+	window dataaccess.TimeWindow,
+	cursor pagination.Cursor,
+	pageSize int,
+) ([]dataaccess.Decision, error) {
+	if s.listNewLikedYouErr != nil {
+		return nil, s.listNewLikedYouErr
+	}
+	return s.Store.ListNewLikedYou(ctx, recipientID, order, window, cursor, pageSize)
+}
+
+func (s *stubStore) CountLikedYou(ctx context.Context, recipientID string, window dataaccess.TimeWindow) (uint64, error) {
+	if s.countLikedYouErr != nil {
+		return 0, s.countLikedYouErr
+	}
+	return s.Store.CountLikedYou(ctx, recipientID, window)
+}
+
+func (s *stubStore) RecordDecision(ctx context.Context, actorID, recipientID string, liked bool) (dataaccess.DecisionResult, error) {
+	if s.recordDecisionErr != nil {
+		return dataaccess.DecisionResult{}, s.recordDecisionErr
+	}
+	return s.Store.RecordDecision(ctx, actorID, recipientID, liked)
+}
+
+func (s *stubStore) RecordDecisions(ctx context.Context, actorID string, decisions []dataaccess.DecisionInput) ([]dataaccess.DecisionResult, error) {
+	if s.recordDecisionsErr != nil {
+		return nil, s.recordDecisionsErr
+	}
+	return s.Store.RecordDecisions(ctx, actorID, decisions)
+}
+
+func (s *stubStore) ListMatches(ctx context.Context, userID string, cursor pagination.Cursor, pageSize int) ([]dataaccess.Match, error) {
+	if s.listMatchesErr != nil {
+		return nil, s.listMatchesErr
+	}
+	return s.Store.ListMatches(ctx, userID, cursor, pageSize)
+}
@@ -5,6 +5,26 @@ import "regexp"
 const (
 	likedYouDefaultPageSize = 5
 	likedYouMaxPageSize     = 100
+
+	matchesDefaultPageSize = 20
+	matchesMaxPageSize     = 100
+
+	blockedUsersDefaultPageSize = 20
+	blockedUsersMaxPageSize     = 100
+
+	decisionHistoryDefaultPageSize = 20
+	decisionHistoryMaxPageSize     = 100
+
+	webhookDeliveriesDefaultPageSize = 20
+	webhookDeliveriesMaxPageSize     = 100
+
+	putDecisionsMaxBatchSize = 100
+
+	filterUndecidedMaxCandidates = 500
+
+	batchGetDecisionsMaxPairs = 500
+
+	usernameMaxLength = 50
 )
 
 var uuidRegex = regexp.MustCompile(`^[a-fA-F0-9-]{36}$`)
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) Unmatch(ctx context.Context, req *pb.UnmatchRequest) (*pb.UnmatchResponse, error) {
+	err := validateUnmatchRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.Unmatch(ctx, req.ActorUserId, req.OtherUserId)
+	if errors.Is(err, dataaccess.ErrNoActiveMatch) {
+		return nil, status.Error(codes.NotFound, "users are not matched")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "Unmatch() error: %v", err)
+	}
+
+	return &pb.UnmatchResponse{}, nil
+}
+
+func validateUnmatchRequest(req *pb.UnmatchRequest) error {
+	errList := []string{}
+
+	actorUserID := req.GetActorUserId()
+	if actorUserID == "" {
+		errList = append(errList, "actor_user_id is required")
+	}
+	if !uuidRegex.MatchString(actorUserID) {
+		errList = append(errList, "actor_user_id must be a valid UUID")
+	}
+
+	otherUserID := req.GetOtherUserId()
+	if otherUserID == "" {
+		errList = append(errList, "other_user_id is required")
+	}
+	if !uuidRegex.MatchString(otherUserID) {
+		errList = append(errList, "other_user_id must be a valid UUID")
+	}
+
+	if actorUserID == otherUserID {
+		errList = append(errList, "other_user_id must not equal actor_user_id")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
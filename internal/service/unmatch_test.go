@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnmatch_HidesUsersFromEachOther(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+	userA, userB := testActorID(1), testRecipientID
+
+	put := func(actor, recipient string, liked bool) *pb.PutDecisionResponse {
+		resp, err := s.PutDecision(ctx, &pb.PutDecisionRequest{
+			ActorUserId:     actor,
+			RecipientUserId: recipient,
+			LikedRecipient:  liked,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	put(userA, userB, true)
+	require.NotNil(t, put(userB, userA, true).Match)
+
+	_, err := s.Unmatch(ctx, &pb.UnmatchRequest{ActorUserId: userA, OtherUserId: userB})
+	require.NoError(t, err)
+
+	// a second unmatch has nothing left to end
+	_, err = s.Unmatch(ctx, &pb.UnmatchRequest{ActorUserId: userB, OtherUserId: userA})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// liking again neither resurfaces the pair nor re-matches them
+	require.False(t, put(userA, userB, true).MutualLikes)
+
+	for _, tc := range []struct{ user, other string }{{userA, userB}, {userB, userA}} {
+		list, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: tc.user})
+		require.NoError(t, err)
+		require.Empty(t, list.Likers)
+
+		newList, err := s.ListNewLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: tc.user})
+		require.NoError(t, err)
+		require.Empty(t, newList.Likers)
+
+		count, err := s.CountLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: tc.user})
+		require.NoError(t, err)
+		require.Zero(t, count.Count)
+
+		matches, err := s.ListMatches(ctx, &pb.ListMatchesRequest{UserId: tc.user})
+		require.NoError(t, err)
+		require.Empty(t, matches.Matches)
+	}
+}
+
+func TestUnmatch_Errors(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	_, err := s.Unmatch(context.Background(), &pb.UnmatchRequest{ActorUserId: testRecipientID, OtherUserId: testRecipientID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.Unmatch(context.Background(), &pb.UnmatchRequest{ActorUserId: testRecipientID, OtherUserId: testActorID(1)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
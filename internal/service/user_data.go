@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+)
+
+func (s *ExploreServiceServer) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.UserDataExport, error) {
+	err := validateUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.Repo.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, userError("ExportUserData", err)
+	}
+
+	resp := &pb.UserDataExport{
+		User:                   toPBUser(data.User),
+		DecisionsMade:          toPBExportDecisions(data.DecisionsMade),
+		DecisionsReceived:      toPBExportDecisions(data.DecisionsReceived),
+		DecisionEventsMade:     toPBExportDecisionEvents(data.EventsMade),
+		DecisionEventsReceived: toPBExportDecisionEvents(data.EventsReceived),
+		ExportedUnixTimestamp:  uint64(time.Now().Unix()),
+	}
+	for _, m := range data.Matches {
+		match := &pb.UserDataExport_Match{
+			MatchId:              m.ID,
+			UserId:               m.UserID,
+			MatchedUnixTimestamp: uint64(m.MatchedAtUnix),
+		}
+		if m.UnmatchedAtUnix != 0 {
+			unmatchedAt := uint64(m.UnmatchedAtUnix)
+			match.UnmatchedUnixTimestamp = &unmatchedAt
+		}
+		if m.UnmatchedBy != "" {
+			unmatchedBy := m.UnmatchedBy
+			match.UnmatchedByUserId = &unmatchedBy
+		}
+		resp.Matches = append(resp.Matches, match)
+	}
+	for _, b := range data.Blocks {
+		resp.Blocks = append(resp.Blocks, &pb.UserDataExport_Block{
+			BlockedUserId: b.BlockedID,
+			UnixTimestamp: uint64(b.CreatedAtUnix),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *ExploreServiceServer) EraseUserData(ctx context.Context, req *pb.EraseUserDataRequest) (*pb.EraseUserDataResponse, error) {
+	err := validateUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	erasure, err := s.Repo.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, userError("EraseUserData", err)
+	}
+
+	return &pb.EraseUserDataResponse{
+		ErasureId:              erasure.ID,
+		UserId:                 erasure.UserID,
+		RequestedUnixTimestamp: uint64(erasure.RequestedAtUnix),
+		DecisionsDeleted:       uint64(erasure.DecisionsDeleted),
+		MatchesDeleted:         uint64(erasure.MatchesDeleted),
+		BlocksDeleted:          uint64(erasure.BlocksDeleted),
+		AlreadyErased:          erasure.AlreadyErased,
+	}, nil
+}
+
+func toPBExportDecisions(records []dataaccess.DecisionRecord) []*pb.UserDataExport_Decision {
+	decisions := make([]*pb.UserDataExport_Decision, 0, len(records))
+	for _, d := range records {
+		decisions = append(decisions, &pb.UserDataExport_Decision{
+			ActorUserId:          d.ActorID,
+			RecipientUserId:      d.RecipientID,
+			LikedRecipient:       d.Liked,
+			CreatedUnixTimestamp: uint64(d.CreatedAtUnix),
+			UpdatedUnixTimestamp: uint64(d.UpdatedAtUnix),
+		})
+	}
+	return decisions
+}
+
+func toPBExportDecisionEvents(events []dataaccess.DecisionEvent) []*pb.UserDataExport_DecisionEvent {
+	results := make([]*pb.UserDataExport_DecisionEvent, 0, len(events))
+	for _, e := range events {
+		results = append(results, &pb.UserDataExport_DecisionEvent{
+			EventId:         uint64(e.ID),
+			ActorUserId:     e.ActorID,
+			RecipientUserId: e.RecipientID,
+			LikedRecipient:  e.Liked,
+			UnixTimestamp:   uint64(e.CreatedAtUnix),
+		})
+	}
+	return results
+}
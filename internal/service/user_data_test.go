@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportAndEraseUserData(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	user := testActorID(0)
+	_, err := s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: user, RecipientUserId: testRecipientID, LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: user, RecipientUserId: testActorID(1), LikedRecipient: false})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: user, RecipientUserId: testActorID(1), LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testRecipientID, RecipientUserId: user, LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testActorID(2), RecipientUserId: testRecipientID, LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.BlockUser(ctx, &pb.BlockUserRequest{ActorUserId: user, BlockedUserId: testActorID(3)})
+	require.NoError(t, err)
+
+	export, err := s.ExportUserData(ctx, &pb.ExportUserDataRequest{UserId: user})
+	require.NoError(t, err)
+	require.Equal(t, user, export.User.UserId)
+	require.Len(t, export.DecisionsMade, 2)
+	require.Len(t, export.DecisionsReceived, 1)
+	require.Equal(t, testRecipientID, export.DecisionsReceived[0].ActorUserId)
+	// the history keeps the pass on testActorID(1) that the like replaced
+	require.Len(t, export.DecisionEventsMade, 3)
+	require.Equal(t, testActorID(1), export.DecisionEventsMade[0].RecipientUserId)
+	require.True(t, export.DecisionEventsMade[0].LikedRecipient)
+	require.False(t, export.DecisionEventsMade[1].LikedRecipient)
+	require.Greater(t, export.DecisionEventsMade[0].EventId, export.DecisionEventsMade[1].EventId)
+	require.Len(t, export.DecisionEventsReceived, 1)
+	require.Equal(t, testRecipientID, export.DecisionEventsReceived[0].ActorUserId)
+	require.Len(t, export.Matches, 1)
+	require.Equal(t, testRecipientID, export.Matches[0].UserId)
+	require.Len(t, export.Blocks, 1)
+	require.Equal(t, testActorID(3), export.Blocks[0].BlockedUserId)
+
+	erased, err := s.EraseUserData(ctx, &pb.EraseUserDataRequest{UserId: user})
+	require.NoError(t, err)
+	require.NotEmpty(t, erased.ErasureId)
+	require.Equal(t, uint64(3), erased.DecisionsDeleted)
+	require.Equal(t, uint64(1), erased.MatchesDeleted)
+	require.Equal(t, uint64(1), erased.BlocksDeleted)
+	require.False(t, erased.AlreadyErased)
+
+	// the recipient only sees the remaining liker
+	count, err := s.CountLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: testRecipientID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count.Count)
+	matches, err := s.ListMatches(ctx, &pb.ListMatchesRequest{UserId: testRecipientID})
+	require.NoError(t, err)
+	require.Empty(t, matches.Matches)
+
+	export, err = s.ExportUserData(ctx, &pb.ExportUserDataRequest{UserId: user})
+	require.NoError(t, err)
+	require.Equal(t, "erased-"+user, export.User.Username)
+	require.NotNil(t, export.User.ErasedUnixTimestamp)
+	require.NotNil(t, export.User.DeactivatedUnixTimestamp)
+	require.Empty(t, export.DecisionsMade)
+	require.Empty(t, export.DecisionsReceived)
+	require.Empty(t, export.DecisionEventsMade)
+	require.Empty(t, export.DecisionEventsReceived)
+	require.Empty(t, export.Matches)
+	require.Empty(t, export.Blocks)
+
+	again, err := s.EraseUserData(ctx, &pb.EraseUserDataRequest{UserId: user})
+	require.NoError(t, err)
+	require.True(t, again.AlreadyErased)
+	require.NotEqual(t, erased.ErasureId, again.ErasureId)
+	require.Zero(t, again.DecisionsDeleted)
+
+	_, err = s.ReactivateUser(ctx, &pb.ReactivateUserRequest{UserId: user})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestEraseUserData_NotFound(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	_, err := s.EraseUserData(context.Background(), &pb.EraseUserDataRequest{UserId: "00000000-0000-0000-0000-000000000000"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.ExportUserData(context.Background(), &pb.ExportUserDataRequest{UserId: "not-a-uuid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
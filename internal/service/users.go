@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	err := validateCreateUserRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Repo.CreateUser(ctx, uuid.NewString(), req.Username)
+	if errors.Is(err, dataaccess.ErrUsernameTaken) {
+		return nil, status.Error(codes.AlreadyExists, "username already taken")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "CreateUser() error: %v", err)
+	}
+
+	return toPBUser(user), nil
+}
+
+func (s *ExploreServiceServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	err := validateUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Repo.GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, userError("GetUser", err)
+	}
+
+	return toPBUser(user), nil
+}
+
+func (s *ExploreServiceServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	err := validateUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.DeleteUser(ctx, req.UserId)
+	if err != nil {
+		return nil, userError("DeleteUser", err)
+	}
+
+	return &pb.DeleteUserResponse{}, nil
+}
+
+func (s *ExploreServiceServer) DeactivateUser(ctx context.Context, req *pb.DeactivateUserRequest) (*pb.User, error) {
+	err := validateUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Repo.SetUserActive(ctx, req.UserId, false)
+	if err != nil {
+		return nil, userError("SetUserActive", err)
+	}
+
+	return toPBUser(user), nil
+}
+
+func (s *ExploreServiceServer) ReactivateUser(ctx context.Context, req *pb.ReactivateUserRequest) (*pb.User, error) {
+	err := validateUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Repo.SetUserActive(ctx, req.UserId, true)
+	if err != nil {
+		return nil, userError("SetUserActive", err)
+	}
+
+	return toPBUser(user), nil
+}
+
+// userError maps repository errors for calls referencing users to gRPC statuses.
+func userError(method string, err error) error {
+	if errors.Is(err, dataaccess.ErrUserNotFound) {
+		return status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, dataaccess.ErrUserErased) {
+		return status.Error(codes.FailedPrecondition, "user data was erased")
+	}
+	return status.Errorf(codes.Unknown, "%s() error: %v", method, err)
+}
+
+func toPBUser(u dataaccess.User) *pb.User {
+	user := &pb.User{
+		UserId:               u.ID,
+		Username:             u.Username,
+		CreatedUnixTimestamp: uint64(u.CreatedAtUnix),
+	}
+	if u.DeactivatedAtUnix != 0 {
+		deactivatedAt := uint64(u.DeactivatedAtUnix)
+		user.DeactivatedUnixTimestamp = &deactivatedAt
+	}
+	if u.ErasedAtUnix != 0 {
+		erasedAt := uint64(u.ErasedAtUnix)
+		user.ErasedUnixTimestamp = &erasedAt
+	}
+	return user
+}
+
+func validateCreateUserRequest(req *pb.CreateUserRequest) error {
+	errList := []string{}
+
+	username := req.GetUsername()
+	if strings.TrimSpace(username) == "" {
+		errList = append(errList, "username is required")
+	}
+	if utf8.RuneCountInString(username) > usernameMaxLength {
+		errList = append(errList, fmt.Sprintf("username cannot exceed %v characters", usernameMaxLength))
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
+
+func validateUserID(userID string) error {
+	errList := []string{}
+
+	if userID == "" {
+		errList = append(errList, "user_id is required")
+	}
+	if !uuidRegex.MatchString(userID) {
+		errList = append(errList, "user_id must be a valid UUID")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserLifecycle(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	ctx := context.Background()
+
+	user, err := s.CreateUser(ctx, &pb.CreateUserRequest{Username: "new-user"})
+	require.NoError(t, err)
+	require.Regexp(t, uuidRegex, user.UserId)
+	require.Equal(t, "new-user", user.Username)
+	require.Nil(t, user.DeactivatedUnixTimestamp)
+
+	_, err = s.CreateUser(ctx, &pb.CreateUserRequest{Username: "new-user"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	got, err := s.GetUser(ctx, &pb.GetUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	require.Equal(t, user.UserId, got.UserId)
+
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: user.UserId, RecipientUserId: testRecipientID, LikedRecipient: true})
+	require.NoError(t, err)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testActorID(1), RecipientUserId: testRecipientID, LikedRecipient: true})
+	require.NoError(t, err)
+
+	requireLikers := func(want, wantNew int) {
+		t.Helper()
+		list, err := s.ListLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID})
+		require.NoError(t, err)
+		require.Len(t, list.Likers, want)
+
+		newList, err := s.ListNewLikedYou(ctx, &pb.ListLikedYouRequest{RecipientUserId: testRecipientID})
+		require.NoError(t, err)
+		require.Len(t, newList.Likers, wantNew)
+
+		count, err := s.CountLikedYou(ctx, &pb.CountLikedYouRequest{RecipientUserId: testRecipientID})
+		require.NoError(t, err)
+		require.Equal(t, uint64(want), count.Count)
+	}
+	requireLikers(2, 2)
+
+	deactivated, err := s.DeactivateUser(ctx, &pb.DeactivateUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	require.NotNil(t, deactivated.DeactivatedUnixTimestamp)
+	requireLikers(1, 1)
+
+	// liking back a deactivated user does not match
+	resp, err := s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testRecipientID, RecipientUserId: user.UserId, LikedRecipient: true})
+	require.NoError(t, err)
+	require.False(t, resp.MutualLikes)
+
+	reactivated, err := s.ReactivateUser(ctx, &pb.ReactivateUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	require.Nil(t, reactivated.DeactivatedUnixTimestamp)
+	requireLikers(2, 1) // the like back made them no longer new
+
+	_, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	requireLikers(1, 1)
+
+	_, err = s.GetUser(ctx, &pb.GetUserRequest{UserId: user.UserId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: user.UserId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeactivateUser(ctx, &pb.DeactivateUserRequest{UserId: user.UserId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateUser_Validation(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+
+	_, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{Username: " "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	long := make([]byte, usernameMaxLength+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	_, err = s.CreateUser(context.Background(), &pb.CreateUserRequest{Username: string(long)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.GetUser(context.Background(), &pb.GetUserRequest{UserId: "nope"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/events"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ExploreServiceServer) WatchLikedYou(req *pb.WatchLikedYouRequest, stream pb.ExploreService_WatchLikedYouServer) error {
+	err := validateWatchLikedYouRequest(req)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if _, err := s.Repo.GetUser(ctx, req.RecipientUserId); err != nil {
+		return userError("GetUser", err)
+	}
+
+	watcher, err := s.Events.Watch(req.RecipientUserId, req.GetResumeToken())
+	if err != nil {
+		if errors.Is(err, events.ErrInvalidToken) {
+			return status.Error(codes.InvalidArgument, "invalid resume_token")
+		}
+		return status.Errorf(codes.Unknown, "Watch() error: %v", err)
+	}
+	defer watcher.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-watcher.Events():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell behind, reconnect with the last resume_token")
+			}
+			if err := stream.Send(s.toPBLikedYouEvent(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publishDecision publishes the like or match a recorded decision produced.
+// The decision is already committed, so a failed publish is only logged and
+// watchers catch up on their next refetch.
+func (s *ExploreServiceServer) publishDecision(ctx context.Context, actorID, recipientID string, result dataaccess.DecisionResult) {
+	now := time.Now().Unix()
+
+	var published []events.Event
+	switch {
+	case result.Match != nil:
+		published = []events.Event{
+			{Type: events.Match, RecipientID: recipientID, ActorID: actorID, MatchID: result.Match.ID, UnixTs: now},
+			{Type: events.Match, RecipientID: actorID, ActorID: recipientID, MatchID: result.Match.ID, UnixTs: now},
+		}
+	case result.NewLike:
+		published = []events.Event{{Type: events.Like, RecipientID: recipientID, ActorID: actorID, UnixTs: now}}
+	}
+
+	for _, e := range published {
+		if err := s.Events.Publish(ctx, e); err != nil {
+			log.Printf("failed to publish liked you event for %s: %v", e.RecipientID, err)
+		}
+	}
+}
+
+func (s *ExploreServiceServer) toPBLikedYouEvent(e events.Event) *pb.LikedYouEvent {
+	event := &pb.LikedYouEvent{
+		ActorUserId:   e.ActorID,
+		UnixTimestamp: uint64(e.UnixTs),
+		ResumeToken:   s.Events.Token(e),
+	}
+	switch e.Type {
+	case events.Like:
+		event.Type = pb.LikedYouEventType_LIKED_YOU_EVENT_TYPE_LIKE
+	case events.Match:
+		event.Type = pb.LikedYouEventType_LIKED_YOU_EVENT_TYPE_MATCH
+		event.MatchId = &e.MatchID
+	case events.Resync:
+		event.Type = pb.LikedYouEventType_LIKED_YOU_EVENT_TYPE_RESYNC
+	}
+	return event
+}
+
+func validateWatchLikedYouRequest(req *pb.WatchLikedYouRequest) error {
+	errList := []string{}
+
+	recipientUserID := req.GetRecipientUserId()
+	if recipientUserID == "" {
+		errList = append(errList, "recipient_user_id is required")
+	}
+	if !uuidRegex.MatchString(recipientUserID) {
+		errList = append(errList, "recipient_user_id must be a valid UUID")
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
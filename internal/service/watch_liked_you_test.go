@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchStream is a server stream handing the sent events to the test.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.LikedYouEvent
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(e *pb.LikedYouEvent) error {
+	s.events <- e
+	return nil
+}
+
+func receive(t *testing.T, stream *watchStream) *pb.LikedYouEvent {
+	t.Helper()
+	select {
+	case e := <-stream.events:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for an event")
+		return nil
+	}
+}
+
+func TestWatchLikedYou(t *testing.T) {
+	ctx := context.Background()
+	s := NewExploreServiceServer(newStubStore())
+
+	like := func(actorID, recipientID string) {
+		t.Helper()
+		_, err := s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: actorID, RecipientUserId: recipientID, LikedRecipient: true})
+		require.NoError(t, err)
+	}
+
+	// take a resume token from a watcher of our own
+	w, err := s.Events.Watch(testRecipientID, "")
+	require.NoError(t, err)
+	like(testActorID(0), testRecipientID)
+	token := s.Events.Token(<-w.Events())
+	w.Close()
+
+	// missed while disconnected, passes and other recipients don't notify
+	like(testActorID(1), testRecipientID)
+	_, err = s.PutDecision(ctx, &pb.PutDecisionRequest{ActorUserId: testActorID(2), RecipientUserId: testRecipientID})
+	require.NoError(t, err)
+	like(testActorID(3), testActorID(4))
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := &watchStream{ctx: streamCtx, events: make(chan *pb.LikedYouEvent, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.WatchLikedYou(&pb.WatchLikedYouRequest{RecipientUserId: testRecipientID, ResumeToken: &token}, stream)
+	}()
+
+	replayed := receive(t, stream)
+	require.Equal(t, pb.LikedYouEventType_LIKED_YOU_EVENT_TYPE_LIKE, replayed.Type)
+	require.Equal(t, testActorID(1), replayed.ActorUserId)
+	require.NotEmpty(t, replayed.ResumeToken)
+
+	// liking back matches, the recipient is told who with
+	like(testRecipientID, testActorID(1))
+	matched := receive(t, stream)
+	require.Equal(t, pb.LikedYouEventType_LIKED_YOU_EVENT_TYPE_MATCH, matched.Type)
+	require.Equal(t, testActorID(1), matched.ActorUserId)
+	require.NotEmpty(t, matched.GetMatchId())
+
+	// a repeated like isn't new
+	like(testActorID(1), testRecipientID)
+	like(testActorID(5), testRecipientID)
+	require.Equal(t, testActorID(5), receive(t, stream).ActorUserId)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	// resuming from an unknown position asks the client to refetch
+	other := NewExploreServiceServer(newStubStore())
+	streamCtx, cancel = context.WithCancel(ctx)
+	stream = &watchStream{ctx: streamCtx, events: make(chan *pb.LikedYouEvent, 10)}
+	go func() {
+		done <- other.WatchLikedYou(&pb.WatchLikedYouRequest{RecipientUserId: testRecipientID, ResumeToken: &token}, stream)
+	}()
+	require.Equal(t, pb.LikedYouEventType_LIKED_YOU_EVENT_TYPE_RESYNC, receive(t, stream).Type)
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestWatchLikedYou_Errors(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	invalidToken := "!!"
+
+	tests := []struct {
+		name        string
+		req         *pb.WatchLikedYouRequest
+		wantErrCode codes.Code
+	}{
+		{
+			name:        "invalid recipient UUID",
+			req:         &pb.WatchLikedYouRequest{RecipientUserId: "invalid"},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:        "unknown recipient",
+			req:         &pb.WatchLikedYouRequest{RecipientUserId: "00000000-0000-0000-0000-000000000000"},
+			wantErrCode: codes.NotFound,
+		},
+		{
+			name:        "invalid resume token",
+			req:         &pb.WatchLikedYouRequest{RecipientUserId: testRecipientID, ResumeToken: &invalidToken},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &watchStream{ctx: context.Background(), events: make(chan *pb.LikedYouEvent, 10)}
+			err := s.WatchLikedYou(tt.req, stream)
+			require.Error(t, err)
+			require.Equal(t, tt.wantErrCode, status.Code(err))
+		})
+	}
+}
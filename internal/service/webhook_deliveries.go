@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/pagination"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var webhookStatuses = map[pb.WebhookDeliveryStatus]dataaccess.WebhookStatus{
+	pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:   dataaccess.WebhookPending,
+	pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED: dataaccess.WebhookDelivered,
+	pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:      dataaccess.WebhookDead,
+}
+
+func (s *ExploreServiceServer) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	err := validateListWebhookDeliveriesRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = webhookDeliveriesDefaultPageSize
+	}
+
+	// delivery tokens carry the id of the last delivery, the timestamp is informational
+	cursor, err := pagination.Decode(req.GetPaginationToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination_token: %v", err)
+	}
+	var beforeID int64
+	if !cursor.IsZero() {
+		beforeID, err = strconv.ParseInt(cursor.ID, 10, 64)
+		if err != nil || beforeID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination_token: not a webhook deliveries token")
+		}
+	}
+
+	filter := dataaccess.WebhookDeliveryFilter{MatchID: req.GetMatchId()}
+	if req.Status != nil {
+		filter.Status = webhookStatuses[req.GetStatus()]
+	}
+
+	deliveries, err := s.Repo.ListWebhookDeliveries(ctx, filter, beforeID, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "ListWebhookDeliveries() error: %v", err)
+	}
+
+	var nextToken string
+
+	// check if next page is needed
+	if len(deliveries) > pageSize {
+		last := deliveries[pageSize-1]
+		nextToken = pagination.Encode(pagination.Cursor{UnixTs: last.CreatedAtUnix, ID: strconv.FormatInt(last.ID, 10)})
+		deliveries = deliveries[:pageSize]
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		pbDeliveries = append(pbDeliveries, toPBWebhookDelivery(d))
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{
+		Deliveries:          pbDeliveries,
+		NextPaginationToken: &nextToken,
+	}, nil
+}
+
+func toPBWebhookDelivery(d dataaccess.WebhookDelivery) *pb.WebhookDelivery {
+	delivery := &pb.WebhookDelivery{
+		DeliveryId:           uint64(d.ID),
+		EventId:              d.EventID,
+		MatchId:              d.MatchID,
+		EndpointUrl:          d.EndpointURL,
+		Attempts:             uint32(d.Attempts),
+		CreatedUnixTimestamp: uint64(d.CreatedAtUnix),
+		UpdatedUnixTimestamp: uint64(d.UpdatedAtUnix),
+	}
+	for pbStatus, status := range webhookStatuses {
+		if status == d.Status {
+			delivery.Status = pbStatus
+		}
+	}
+	if d.LastStatusCode != 0 {
+		lastStatusCode := uint32(d.LastStatusCode)
+		delivery.LastStatusCode = &lastStatusCode
+	}
+	if d.LastError != "" {
+		lastError := d.LastError
+		delivery.LastError = &lastError
+	}
+	if d.NextAttemptAtUnix != 0 {
+		nextAttemptAt := uint64(d.NextAttemptAtUnix)
+		delivery.NextAttemptUnixTimestamp = &nextAttemptAt
+	}
+	return delivery
+}
+
+func validateListWebhookDeliveriesRequest(req *pb.ListWebhookDeliveriesRequest) error {
+	errList := []string{}
+
+	if req.MatchId != nil && !uuidRegex.MatchString(req.GetMatchId()) {
+		errList = append(errList, "match_id must be a valid UUID")
+	}
+
+	if req.Status != nil {
+		if _, ok := webhookStatuses[req.GetStatus()]; !ok {
+			errList = append(errList, "status must be PENDING, DELIVERED or DEAD")
+		}
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize > webhookDeliveriesMaxPageSize {
+		errList = append(errList, fmt.Sprintf("page_size cannot exceed %v", webhookDeliveriesMaxPageSize))
+	}
+
+	paginationToken := req.GetPaginationToken()
+	if paginationToken != "" {
+		if _, err := base64.StdEncoding.DecodeString(paginationToken); err != nil {
+			errList = append(errList, "pagination_token must be valid base64")
+		}
+	}
+
+	if len(errList) > 0 {
+		return status.Errorf(codes.InvalidArgument, "request validation errors: [%v]", strings.Join(errList, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListWebhookDeliveries(t *testing.T) {
+	repo := newStubStore()
+	s := NewExploreServiceServer(repo)
+	ctx := context.Background()
+
+	matchID := testActorID(50)
+	var deliveries []dataaccess.WebhookDelivery
+	for i := 0; i < 5; i++ {
+		deliveries = append(deliveries, dataaccess.WebhookDelivery{
+			EventID:     fmt.Sprintf("event-%d", i),
+			MatchID:     matchID,
+			EndpointURL: "https://partner.example/hook",
+			Payload:     []byte("{}"),
+		})
+	}
+	deliveries[4].MatchID = testActorID(51)
+	require.NoError(t, repo.EnqueueWebhookDeliveries(ctx, deliveries))
+
+	// the oldest one fails for good
+	claimed, err := repo.ClaimWebhookDeliveries(ctx, 1, 0)
+	require.NoError(t, err)
+	require.NoError(t, repo.RecordWebhookAttempt(ctx, claimed[0].ID, dataaccess.WebhookAttempt{StatusCode: 500, Err: "unexpected status", Dead: true}))
+
+	var eventIDs []string
+	token := ""
+	for {
+		resp, err := s.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+			MatchId:         &matchID,
+			PaginationToken: &token,
+			PageSize:        proto.Uint32(3),
+		})
+		require.NoError(t, err)
+		for _, d := range resp.Deliveries {
+			eventIDs = append(eventIDs, d.EventId)
+		}
+		token = resp.GetNextPaginationToken()
+		if token == "" {
+			break
+		}
+	}
+	require.Equal(t, []string{"event-3", "event-2", "event-1", "event-0"}, eventIDs)
+
+	resp, err := s.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+		Status: pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD.Enum(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Deliveries, 1)
+	dead := resp.Deliveries[0]
+	require.Equal(t, "event-0", dead.EventId)
+	require.Equal(t, pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD, dead.Status)
+	require.Equal(t, uint32(1), dead.Attempts)
+	require.Equal(t, uint32(500), dead.GetLastStatusCode())
+	require.Equal(t, "unexpected status", dead.GetLastError())
+	require.Nil(t, dead.NextAttemptUnixTimestamp)
+
+	resp, err = s.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+		Status: pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING.Enum(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Deliveries, 4)
+	require.NotNil(t, resp.Deliveries[0].NextAttemptUnixTimestamp)
+}
+
+func TestListWebhookDeliveries_Validation(t *testing.T) {
+	s := NewExploreServiceServer(newStubStore())
+	invalid := "invalid"
+
+	tests := []struct {
+		name string
+		req  *pb.ListWebhookDeliveriesRequest
+	}{
+		{"invalid match id", &pb.ListWebhookDeliveriesRequest{MatchId: &invalid}},
+		{"unspecified status", &pb.ListWebhookDeliveriesRequest{Status: pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED.Enum()}},
+		{"page size too large", &pb.ListWebhookDeliveriesRequest{PageSize: proto.Uint32(webhookDeliveriesMaxPageSize + 1)}},
+		{"invalid token", &pb.ListWebhookDeliveriesRequest{PaginationToken: proto.String("!!")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ListWebhookDeliveries(context.Background(), tt.req)
+			require.Error(t, err)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
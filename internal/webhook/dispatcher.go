@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/outbox"
+)
+
+// DeliveryStore holds the deliveries the Dispatcher sends, dataaccess.Store implements it.
+type DeliveryStore interface {
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]dataaccess.WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, id int64, attempt dataaccess.WebhookAttempt) error
+}
+
+type Config struct {
+	// Secret keys the HMAC signature of every request.
+	Secret []byte
+	// MaxAttempts is how many times a delivery is attempted before it is
+	// dead-lettered.
+	MaxAttempts int
+	// BatchSize is how many deliveries are claimed, and sent concurrently, at a time.
+	BatchSize int
+	// PollInterval is how long the dispatcher waits after finding fewer than BatchSize deliveries.
+	PollInterval time.Duration
+	// Timeout bounds every request.
+	Timeout time.Duration
+	// Lease hides claimed deliveries from other dispatchers, it must cover Timeout.
+	Lease time.Duration
+	// MinBackoff is the delay before the second attempt, it doubles with
+	// every failed attempt up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig returns the dispatcher settings used by the server.
+func DefaultConfig(secret []byte) Config {
+	return Config{
+		Secret:       secret,
+		MaxAttempts:  8,
+		BatchSize:    20,
+		PollInterval: time.Second,
+		Timeout:      10 * time.Second,
+		Lease:        time.Minute,
+		MinBackoff:   5 * time.Second,
+		MaxBackoff:   30 * time.Minute,
+	}
+}
+
+// Dispatcher POSTs pending deliveries to their endpoints. A delivery counts
+// as delivered on any 2xx response, anything else is retried with exponential
+// backoff until MaxAttempts, after which the delivery is dead-lettered and
+// only shows up in ListWebhookDeliveries.
+type Dispatcher struct {
+	store  DeliveryStore
+	client *http.Client
+	cfg    Config
+	now    func() time.Time
+}
+
+func NewDispatcher(store DeliveryStore, cfg Config) *Dispatcher {
+	return &Dispatcher{
+		store:  store,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// Run dispatches deliveries until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		claimed, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("webhook dispatcher error: %v", err)
+		}
+		if err == nil && claimed == d.cfg.BatchSize {
+			continue // more are waiting
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+// DispatchOnce sends one batch of deliveries and returns how many were claimed.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	deliveries, err := d.store.ClaimWebhookDeliveries(ctx, d.cfg.BatchSize, d.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attempt := d.attempt(ctx, delivery)
+			if attempt.Dead {
+				log.Printf("webhook delivery %d of event %s to %s dead-lettered after %d attempts: %s",
+					delivery.ID, delivery.EventID, delivery.EndpointURL, delivery.Attempts+1, attempt.Err)
+			}
+			if err := d.store.RecordWebhookAttempt(ctx, delivery.ID, attempt); err != nil {
+				// the lease runs out and the delivery is attempted again
+				log.Printf("failed to record webhook delivery %d: %v", delivery.ID, err)
+			}
+		}()
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// attempt sends the delivery once and returns the outcome.
+func (d *Dispatcher) attempt(ctx context.Context, delivery dataaccess.WebhookDelivery) dataaccess.WebhookAttempt {
+	statusCode, err := d.send(ctx, delivery)
+	if err == nil {
+		return dataaccess.WebhookAttempt{StatusCode: statusCode}
+	}
+	return dataaccess.WebhookAttempt{
+		StatusCode: statusCode,
+		Err:        err.Error(),
+		RetryIn:    outbox.Backoff(d.cfg.MinBackoff, d.cfg.MaxBackoff, delivery.Attempts),
+		Dead:       delivery.Attempts+1 >= d.cfg.MaxAttempts,
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery dataaccess.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.EndpointURL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	unixTs := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, delivery.EventID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(unixTs, 10))
+	req.Header.Set(SignatureHeader, Sign(d.cfg.Secret, unixTs, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16)) // lets the connection be reused
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
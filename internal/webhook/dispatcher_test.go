@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/outbox"
+)
+
+var testSecret = []byte("secret")
+
+// receiver is a webhook endpoint checking signatures like a partner would.
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	payloads []Payload
+	invalid  int
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	unixTs, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !Verify(testSecret, unixTs, body, r.Header.Get(SignatureHeader)) {
+		rc.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var p Payload
+	_ = json.Unmarshal(body, &p)
+	if r.Header.Get(EventIDHeader) != p.EventID {
+		rc.invalid++
+	}
+	rc.payloads = append(rc.payloads, p)
+	w.WriteHeader(rc.status)
+}
+
+func testConfig() Config {
+	cfg := DefaultConfig(testSecret)
+	cfg.MaxAttempts = 3
+	cfg.MinBackoff = 0 // retry right away, the memory store's clock can't be moved from here
+	return cfg
+}
+
+// matchUsers creates a match between a and b and relays its events through
+// the webhook publisher, twice to mimic an outbox redelivery.
+func matchUsers(t *testing.T, repo *dataaccess.MemoryRepository, endpoints ...string) string {
+	t.Helper()
+	ctx := context.Background()
+	for _, id := range []string{"a", "b"} {
+		if _, err := repo.CreateUser(ctx, id, "username-"+id); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	_, _ = repo.RecordDecision(ctx, "a", "b", true)
+	result, _ := repo.RecordDecision(ctx, "b", "a", true)
+
+	events, _ := repo.ClaimOutboxEvents(ctx, 10, 0)
+	publisher := NewPublisher(repo, endpoints)
+	for i := 0; i < 2; i++ {
+		for _, e := range events {
+			msg := outbox.Message{ID: e.EventID, Type: e.Type, Key: e.Key, Payload: e.Payload}
+			if err := publisher.Publish(ctx, msg); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+	}
+	return result.Match.ID
+}
+
+func TestDispatcher_Delivers(t *testing.T) {
+	ctx := context.Background()
+	rc := &receiver{status: http.StatusNoContent}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	repo := dataaccess.NewMemoryRepository()
+	matchID := matchUsers(t, repo, server.URL)
+
+	claimed, err := NewDispatcher(repo, testConfig()).DispatchOnce(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if claimed != 1 {
+		t.Fatalf("expected 1 delivery for the match only, got %d", claimed)
+	}
+
+	if rc.invalid != 0 || len(rc.payloads) != 1 {
+		t.Fatalf("expected 1 valid request, got %d and %d invalid", len(rc.payloads), rc.invalid)
+	}
+	p := rc.payloads[0]
+	if p.Type != MatchCreatedType || p.MatchID != matchID || p.ActorUserID != "b" || p.RecipientUserID != "a" || p.MatchedUnixTimestamp == 0 {
+		t.Errorf("unexpected payload %+v", p)
+	}
+
+	deliveries, _ := repo.ListWebhookDeliveries(ctx, dataaccess.WebhookDeliveryFilter{MatchID: matchID}, 0, 10)
+	if len(deliveries) != 1 || deliveries[0].Status != dataaccess.WebhookDelivered || deliveries[0].LastStatusCode != http.StatusNoContent {
+		t.Errorf("expected a delivered delivery, got %+v", deliveries)
+	}
+}
+
+func TestDispatcher_RetriesThenDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	rc := &receiver{status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	repo := dataaccess.NewMemoryRepository()
+	matchUsers(t, repo, server.URL)
+	dispatcher := NewDispatcher(repo, testConfig())
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		if claimed, _ := dispatcher.DispatchOnce(ctx); claimed != 1 {
+			t.Fatalf("attempt %d: expected the delivery to be retried, got %d", attempt, claimed)
+		}
+	}
+	if claimed, _ := dispatcher.DispatchOnce(ctx); claimed != 0 {
+		t.Errorf("expected no attempts after the last one, got %d", claimed)
+	}
+	if len(rc.payloads) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(rc.payloads))
+	}
+
+	deliveries, _ := repo.ListWebhookDeliveries(ctx, dataaccess.WebhookDeliveryFilter{Status: dataaccess.WebhookDead}, 0, 10)
+	if len(deliveries) != 1 || deliveries[0].Attempts != 3 || deliveries[0].LastStatusCode != http.StatusServiceUnavailable || deliveries[0].LastError == "" {
+		t.Errorf("expected a dead delivery after 3 attempts, got %+v", deliveries)
+	}
+}
+
+func TestDispatcher_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	var d dataaccess.WebhookDelivery
+	d.EndpointURL = url
+	d.Attempts = 1
+
+	cfg := testConfig()
+	cfg.MinBackoff = time.Second
+	attempt := NewDispatcher(nil, cfg).attempt(context.Background(), d)
+	if attempt.StatusCode != 0 || attempt.Err == "" || attempt.Dead || attempt.RetryIn != 2*time.Second {
+		t.Errorf("expected a retry in 2s without a status, got %+v", attempt)
+	}
+}
@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jacob-alt-del/explore-service/internal/dataaccess"
+	"github.com/jacob-alt-del/explore-service/internal/outbox"
+	pb "github.com/jacob-alt-del/explore-service/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// MatchCreatedType is the type of the MatchCreated payload.
+const MatchCreatedType = "match.created"
+
+// Payload is the JSON body POSTed to the endpoints.
+type Payload struct {
+	EventID              string `json:"event_id"`
+	Type                 string `json:"type"`
+	MatchID              string `json:"match_id"`
+	ActorUserID          string `json:"actor_user_id"`
+	RecipientUserID      string `json:"recipient_user_id"`
+	MatchedUnixTimestamp uint64 `json:"matched_unix_timestamp"`
+}
+
+var matchCreatedName = string((&pb.MatchCreated{}).ProtoReflect().Descriptor().FullName())
+
+// Enqueuer stores deliveries for the Dispatcher, dataaccess.Store implements it.
+type Enqueuer interface {
+	EnqueueWebhookDeliveries(ctx context.Context, deliveries []dataaccess.WebhookDelivery) error
+}
+
+// Publisher is the outbox.Publisher that enqueues a delivery of every
+// MatchCreated event to each endpoint, other events are ignored. Enqueueing
+// the same event again doesn't add deliveries, so outbox redeliveries are
+// harmless.
+type Publisher struct {
+	store     Enqueuer
+	endpoints []string
+}
+
+func NewPublisher(store Enqueuer, endpoints []string) *Publisher {
+	return &Publisher{store: store, endpoints: endpoints}
+}
+
+func (p *Publisher) Publish(ctx context.Context, msg outbox.Message) error {
+	if msg.Type != matchCreatedName {
+		return nil
+	}
+
+	var match pb.MatchCreated
+	if err := proto.Unmarshal(msg.Payload, &match); err != nil {
+		return fmt.Errorf("error decoding %s: %w", msg.Type, err)
+	}
+	body, err := json.Marshal(Payload{
+		EventID:              msg.ID,
+		Type:                 MatchCreatedType,
+		MatchID:              match.MatchId,
+		ActorUserID:          match.ActorUserId,
+		RecipientUserID:      match.RecipientUserId,
+		MatchedUnixTimestamp: match.MatchedUnixTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]dataaccess.WebhookDelivery, 0, len(p.endpoints))
+	for _, url := range p.endpoints {
+		deliveries = append(deliveries, dataaccess.WebhookDelivery{
+			EventID:     msg.ID,
+			MatchID:     match.MatchId,
+			EndpointURL: url,
+			Payload:     body,
+		})
+	}
+	return p.store.EnqueueWebhookDeliveries(ctx, deliveries)
+}
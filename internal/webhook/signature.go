@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// SignatureHeader carries Sign of the request body.
+	SignatureHeader = "X-Explore-Signature"
+	// TimestampHeader carries the unix time the request was signed at.
+	TimestampHeader = "X-Explore-Timestamp"
+	// EventIDHeader carries the event id, retried deliveries reuse it.
+	EventIDHeader = "X-Explore-Event-Id"
+)
+
+// Sign returns the signature of a body sent at unixTs, "sha256=" followed by
+// the hex HMAC-SHA256 of "<unixTs>.<body>" keyed with the secret. Signing the
+// timestamp lets receivers reject replayed requests.
+func Sign(secret []byte, unixTs int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(unixTs, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is Sign of the body, in constant time.
+func Verify(secret []byte, unixTs int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, unixTs, body)), []byte(signature))
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import "testing"
+
+func TestSignVerify(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"event_id":"e1"}`)
+
+	signature := Sign(secret, 1730000000, body)
+	// echo -n '1730000000.{"event_id":"e1"}' | openssl dgst -sha256 -hmac secret
+	if signature != "sha256=c8ecf16bed102d70ba35934e8883568ae5b7efd4f5b9560dfe412f5183ac2297" {
+		t.Fatalf("unexpected signature %s", signature)
+	}
+	if !Verify(secret, 1730000000, body, signature) {
+		t.Errorf("expected the signature to verify")
+	}
+
+	tests := []struct {
+		name   string
+		secret []byte
+		unixTs int64
+		body   []byte
+	}{
+		{"other secret", []byte("other"), 1730000000, body},
+		{"other timestamp", secret, 1730000001, body},
+		{"other body", secret, 1730000000, []byte(`{"event_id":"e2"}`)},
+	}
+	for _, tt := range tests {
+		if Verify(tt.secret, tt.unixTs, tt.body, signature) {
+			t.Errorf("%s: expected the signature not to verify", tt.name)
+		}
+	}
+}